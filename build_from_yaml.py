@@ -10,13 +10,45 @@ from typing import NamedTuple, Optional
 YAML_DIR = 'C:/Users/Win10/Projects/openLCA/repos/olca-schema/yaml'
 OUT_DIR = 'docs'
 
+# the built-in primitive types; see `primitives.yaml` for the format
+BUILTIN_PRIMITIVES = [
+    {'name': 'string', 'targets': {'json': 'string'}},
+    {'name': 'double', 'targets': {'json': 'number'}},
+    {'name': 'float', 'targets': {'json': 'number'}},
+    {'name': 'int', 'targets': {'json': 'integer'}},
+    {'name': 'integer', 'targets': {'json': 'integer'}},
+    {'name': 'boolean', 'targets': {'json': 'boolean'}},
+    {'name': 'bool', 'base': 'boolean'},
+    {'name': 'date', 'base': 'string', 'format': 'date'},
+    {'name': 'dateTime', 'base': 'string', 'format': 'date-time'},
+    {'name': 'GeoJSON', 'format': 'GeoJSON', 'targets': {'json': 'object'}},
+]
+
+
 class Model(NamedTuple):
     classes: dict[str, 'ClassDef']
     enums: dict[str, 'EnumDef']
+    primitives: dict[str, dict[str, any]]
 
     @staticmethod
     def new() -> 'Model':
-        return Model({}, {})
+        primitives = {p['name']: p for p in BUILTIN_PRIMITIVES}
+        return Model({}, {}, primitives)
+
+    def primitive_attr(self, name: str, attr: str) -> Optional[str]:
+        """Returns the first value of the given attribute in the base type chain
+        of the primitive type with the given name. Target mappings are given as
+        `targets.<target>`."""
+        p = self.primitives.get(name)
+        while p is not None:
+            if attr.startswith('targets.'):
+                value = p.get('targets', {}).get(attr[8:])
+            else:
+                value = p.get(attr)
+            if value:
+                return value
+            p = self.primitives.get(p.get('base'))
+        return None
 
     def all_defs(self) -> list:
         defs = []
@@ -37,50 +69,103 @@ class PropDef(NamedTuple):
 
     def to_schema(self) -> dict:
 
-        def schema_type(type_def: str) -> str | dict:
-            match type_def:
-                case 'string': return 'string'
-                case 'double': return 'number'
-                case 'boolean': return 'boolean'
-                case 'int': return 'integer'
-                case 'integer': return 'integer'
-                case _:
-                    if type_def.startswith('Ref['):
-                        return {'$ref': f'Ref.schema.json'}
-                    if type_def[0].isupper():
-                        return {'$ref': f'{type_def}.schema.json'}
-                    print(f'unmatched primitive type: {type_def}')
-                    return type_def
+        def element_schema(type_def: str) -> dict:
+            if type_def.startswith('Ref['):
+                return {'$ref': 'Ref.schema.json'}
+            if type_def in self.model.primitives:
+                schema = {}
+                for attr, keyword in (('targets.json', 'type'),
+                                      ('format', 'format'),
+                                      ('pattern', 'pattern')):
+                    value = self.model.primitive_attr(type_def, attr)
+                    if value:
+                        schema[keyword] = value
+                return schema
+            if type_def[0].isupper():
+                return {'$ref': f'{type_def}.schema.json'}
+            print(f'unmatched primitive type: {type_def}')
+            return {'type': type_def}
 
         schema = {}
         type_def: str = self.obj['type']
+        elements: Optional[dict] = None
         if type_def.startswith('Ref['):
             schema['$ref'] = 'Ref.schema.json'
         elif type_def.startswith('List['):
             item_type = type_def[5:len(type_def)-1]
             schema['type'] = 'array'
-            schema['item'] = schema_type(item_type)
-        elif type_def == 'dateTime':
-            schema['type'] = 'string'
-            schema['format'] = 'date-time'
-        elif type_def == 'date':
-            schema['type'] = 'string'
-            schema['format'] = 'date'
-        elif type_def == 'GeoJSON':
+            elements = element_schema(item_type)
+            schema['items'] = elements
+        elif type_def.startswith('Map['):
+            value_type = type_def[4:len(type_def)-1].split(',', 1)[1].strip()
             schema['type'] = 'object'
-            schema['format'] = 'GeoJSON'
-        elif type_def[0].isupper():
-            schema['$ref'] = f'{type_def}.schema.json'
+            elements = element_schema(value_type)
+            schema['additionalProperties'] = elements
+        elif type_def.startswith('Union['):
+            alternatives = type_def[6:len(type_def)-1].split(',')
+            schema['oneOf'] = [
+                element_schema(alt.strip()) for alt in alternatives]
         else:
-            schema['type'] = schema_type(type_def)
+            schema.update(element_schema(type_def))
 
         doc = self.obj.get('doc')
         if doc:
             schema['description'] = doc
 
+        if is_deprecated(self.obj):
+            schema['deprecated'] = True
+
+        default = self.obj.get('default')
+        if default is not None:
+            schema['default'] = default
+
+        constraints: Optional[dict] = self.obj.get('constraints')
+        if constraints:
+            add_constraints(schema, elements, constraints)
+
         return schema
 
 
+def is_deprecated(obj: dict[str, any]) -> bool:
+    """The `deprecated` field of an element contains a deprecation message or
+    just a boolean flag."""
+    deprecated = obj.get('deprecated')
+    return deprecated is not None and deprecated is not False \
+        and deprecated != 'false'
+
+
+def add_constraints(schema: dict, elements: Optional[dict],
+                    constraints: dict[str, any]):
+    """Adds the property constraints to the schema of a property. For lists and
+    maps, the length constraints are added to the container and the value
+    constraints to the element schema."""
+    keywords = {
+        'min': 'minimum',
+        'max': 'maximum',
+        'exclusiveMin': 'exclusiveMinimum',
+        'exclusiveMax': 'exclusiveMaximum',
+        'pattern': 'pattern',
+        'minLength': 'minLength',
+        'maxLength': 'maxLength',
+        'unit': 'unit',
+    }
+    if elements is not None:
+        keywords['minLength'] = 'minItems' if schema['type'] == 'array' \
+            else 'minProperties'
+        keywords['maxLength'] = 'maxItems' if schema['type'] == 'array' \
+            else 'maxProperties'
+    for key, value in constraints.items():
+        keyword = keywords.get(key)
+        if keyword is None:
+            print(f'unknown constraint: {key}')
+            continue
+        is_length = key in ('minLength', 'maxLength')
+        if elements is None or is_length or key == 'unit':
+            schema[keyword] = value
+        else:
+            elements[keyword] = value
+
+
 class ClassDef(NamedTuple):
     model: Model
     obj: dict[str, any]
@@ -103,14 +188,35 @@ class ClassDef(NamedTuple):
         s = self.super_class()
         return s is not None and s.is_root_entity()
 
+    def is_abstract(self) -> bool:
+        return self.obj.get('abstract', False)
+
+    def mixins(self) -> list['ClassDef']:
+        mixins = []
+        for name in self.obj.get('mixins', []):
+            mixin = self.model.classes.get(name)
+            if mixin:
+                mixins.append(mixin)
+        return mixins
+
     def properties(self) -> list[PropDef]:
         sc = self.super_class()
         properties = sc.properties() if sc else []
         props: list[dict[str, any]] = self.obj.get('properties')
-        if not props:
-            return properties
-        for prop in props:
-            properties.append(PropDef(self.model, self, prop))
+        if props:
+            for prop in props:
+                properties.append(PropDef(self.model, self, prop))
+        for mixin in self.mixins():
+            properties += mixin.mixin_properties()
+        return properties
+
+    def mixin_properties(self) -> list[PropDef]:
+        """Returns the properties a mixin adds to a class: its own properties
+        and the properties of its mixins but not of its super classes."""
+        properties = [PropDef(self.model, self, prop)
+                      for prop in self.obj.get('properties', [])]
+        for mixin in self.mixins():
+            properties += mixin.mixin_properties()
         return properties
 
     def to_schema(self) -> dict:
@@ -126,6 +232,8 @@ class ClassDef(NamedTuple):
         doc = self.doc()
         if doc:
             schema['description'] = doc
+        if is_deprecated(self.obj):
+            schema['deprecated'] = True
 
         proto_idx = 0
 
@@ -141,9 +249,13 @@ class ClassDef(NamedTuple):
             proto_idx = 2
 
         for prop in self.properties():
-            proto_idx += 1
             prop_schema = prop.to_schema()
-            prop_schema['protoIndex'] = proto_idx
+            if prop.obj.get('overlay'):
+                # properties of overlays are numbered by their indices
+                prop_schema['protoIndex'] = prop.obj['index']
+            else:
+                proto_idx += 1
+                prop_schema['protoIndex'] = proto_idx
             schema['properties'][prop.name()] = prop_schema
 
         return schema
@@ -168,6 +280,8 @@ class EnumDef(NamedTuple):
         doc = self.obj.get('doc')
         if doc:
             schema['description'] = doc
+        if is_deprecated(self.obj):
+            schema['deprecated'] = True
 
         items: list[dict[str, any]] = self.obj.get('items', [])
         for item in items:
@@ -176,29 +290,72 @@ class EnumDef(NamedTuple):
         return schema
 
 
+def add_decl(model: Model, decl: dict[str, any]):
+    class_def: Optional[dict] = decl.get('class')
+    if class_def:
+        name: str = class_def['name']
+        model.classes[name] = ClassDef(model, class_def)
+    enum_def: Optional[dict] = decl.get('enum')
+    if enum_def:
+        name: str = enum_def['name']
+        model.enums[name] = EnumDef(enum_def)
+
+
 def main():
     if not os.path.isdir(OUT_DIR):
         os.makedirs(OUT_DIR)
 
+    # the optional schema manifest
+    manifest: dict[str, any] = {}
+    manifest_path = os.path.join(YAML_DIR, 'schema.yaml')
+    if os.path.isfile(manifest_path):
+        with open(manifest_path, 'r', encoding='utf-8') as inp:
+            manifest = yaml.load(inp, yaml.SafeLoader) or {}
+
     model = Model.new()
+    overlays: list[dict[str, any]] = []
     for f in os.listdir(YAML_DIR):
+        if f == 'schema.yaml':
+            continue
+        if f == 'primitives.yaml':
+            with open(os.path.join(YAML_DIR, f), 'r', encoding='utf-8') as inp:
+                for p in yaml.load(inp, yaml.SafeLoader) or []:
+                    model.primitives[p['name']] = p
+            continue
         path = os.path.join(YAML_DIR, f)
         with open(path, 'r', encoding='utf-8') as inp:
             decl: dict[str, any] = yaml.load(inp, yaml.SafeLoader)
-            class_def: Optional[dict] = decl.get('class')
-            if class_def:
-                name: str = class_def['name']
-                model.classes[name] = ClassDef(model, class_def)
-            enum_def: Optional[dict] = decl.get('enum')
-            if enum_def:
-                name: str = enum_def['name']
-                model.enums[name] = EnumDef(enum_def)
+            overlay: Optional[dict] = decl.get('overlay')
+            if overlay:
+                overlays.append(overlay)
+                for type_decl in overlay.get('types', []):
+                    add_decl(model, type_decl)
+                continue
+            add_decl(model, decl)
+
+    # add the properties and items of the overlays to the extended types
+    for overlay in overlays:
+        for ext in overlay.get('extends', []):
+            if ext.get('class'):
+                class_def = model.classes[ext['class']]
+                class_def.obj.setdefault('properties', []).extend(
+                    {**prop, 'overlay': overlay['name']}
+                    for prop in ext.get('properties', []))
+            elif ext.get('enum'):
+                enum_def = model.enums[ext['enum']]
+                enum_def.obj.setdefault('items', []).extend(
+                    ext.get('items', []))
 
     for d in model.all_defs():
         name = d.name()
         if name in ('Entity', 'RootEntity', 'CategorizedEntity'):
             continue
+        if isinstance(d, ClassDef) and d.is_abstract():
+            continue
         schema = d.to_schema()
+        if manifest.get('version'):
+            schema['$comment'] = \
+                f"{manifest.get('name', 'openLCA Schema')} {manifest['version']}"
         path = f'{OUT_DIR}/{name}.schema.json'
         with open(path, 'w', encoding='utf-8') as out:
             json.dump(schema, out, indent=2)
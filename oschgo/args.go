@@ -7,9 +7,14 @@ import (
 )
 
 type args struct {
-	command string
-	yamlDir string
-	target  string
+	command  string
+	yamlDirs []string
+	target   string
+	profile  string
+	rev      string
+	format   string
+	flavor   string
+	tests    string
 }
 
 func parseArgs() *args {
@@ -32,19 +37,30 @@ func parseArgs() *args {
 		}
 		switch flag {
 		case "-i", "-s", "-input", "-schema":
-			args.yamlDir = arg
+			// the input option can be repeated for multiple schema roots
+			args.yamlDirs = append(args.yamlDirs, arg)
 		case "-o", "-output":
 			args.target = arg
+		case "-p", "-profile":
+			args.profile = arg
+		case "-rev":
+			args.rev = arg
+		case "-f", "-format":
+			args.format = arg
+		case "-flavor":
+			args.flavor = arg
+		case "-t", "-tests":
+			args.tests = arg
 		}
 	}
 
-	if args.yamlDir == "" {
+	if len(args.yamlDirs) == 0 {
 		// try to find the schema home by going up the directory tree
 		schemaHome := findSchemaHome()
 		if schemaHome != "" {
-			args.yamlDir = filepath.Join(schemaHome, "yaml")
+			args.yamlDirs = []string{filepath.Join(schemaHome, "yaml")}
 		} else {
-			args.yamlDir = "."
+			args.yamlDirs = []string{"."}
 		}
 	}
 
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// Writes the bundle of the model. The format is taken from the `-format`
+// option or the extension of the output file and is JSON by default.
+func exportBundle(args *args) {
+	model, err := readModel(args)
+	check(err, "could not read YAML model")
+
+	format := args.format
+	if format == "" {
+		format = "json"
+		ext := strings.ToLower(filepath.Ext(args.target))
+		if ext == ".yaml" || ext == ".yml" {
+			format = "yaml"
+		}
+	}
+
+	bundle, err := NewBundle(model)
+	check(err, "could not create bundle")
+	data, err := bundle.Encode(format)
+	check(err, "could not encode bundle")
+	if args.target == "" {
+		fmt.Print(string(data))
+	} else {
+		writeFile(args.target, string(data))
+	}
+}
@@ -2,8 +2,7 @@ package main
 
 import (
 	"fmt"
-	"io/ioutil"
-	"os"
+	"path/filepath"
 )
 
 func main() {
@@ -24,8 +23,12 @@ func main() {
 		writeMarkdownBook(args)
 	case "py", "python":
 		writePythonModule(args)
+	case "ts", "typescript":
+		writeTypeScript(args)
 	case "check":
 		checkSchema(args)
+	case "export":
+		exportBundle(args)
 	default:
 		fmt.Println("unknown command:", args.command)
 	}
@@ -41,19 +44,77 @@ func check(err error, msg ...interface{}) {
 }
 
 func proto(args *args) {
-	yamlModel, err := ReadYamlModel(args.yamlDir)
+	yamlModel, err := readModel(args)
 	check(err)
 
-	proto := GenProto(yamlModel)
+	files, err := GenProto(yamlModel)
+	check(err, "could not generate the proto3 files")
+
+	// with a single package, the target is a file; otherwise it is a folder
+	// in which we write a file for each package
+	if len(files) == 1 {
+		if args.target == "" {
+			fmt.Println(files[0].Content)
+		} else {
+			writeFile(args.target, files[0].Content)
+		}
+		return
+	}
+	if args.target == "" {
+		for _, file := range files {
+			fmt.Println("// file: " + file.Name)
+			fmt.Println(file.Content)
+		}
+		return
+	}
+	mkdir(args.target)
+	for _, file := range files {
+		writeFile(filepath.Join(args.target, file.Name), file.Content)
+	}
+}
+
+// Reads the YAML model from the input roots of the arguments and prunes it to
+// the selected profile, if any. With a git revision, the input roots are read
+// at that revision. The input can also be a single bundle file.
+func readModel(args *args) (*YamlModel, error) {
+	model, err := readInput(args)
+	if err != nil || args.profile == "" {
+		return model, err
+	}
+	if err := model.ApplyProfile(args.profile); err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+func readInput(args *args) (*YamlModel, error) {
+	if isBundleInput(args) {
+		return ReadBundle(args.yamlDirs[0])
+	}
+	sources := make([]*YamlSource, 0, len(args.yamlDirs))
+	for _, dir := range args.yamlDirs {
+		source, err := openSource(args, dir)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, source)
+	}
+	return ReadYamlSources(sources...)
+}
+
+// Returns true if the input of the arguments is a single bundle file.
+func isBundleInput(args *args) bool {
+	return len(args.yamlDirs) == 1 && args.rev == "" &&
+		IsBundleFile(args.yamlDirs[0])
+}
 
-	// print to console or write to file
-	if len(os.Args) < 3 {
-		fmt.Println(proto)
-	} else {
-		outFile := os.Args[2]
-		err := ioutil.WriteFile(outFile, []byte(proto), os.ModePerm)
-		check(err, "failed to write to file", outFile)
+// Opens the given folder or archive path as source, at the git revision of the
+// arguments if one is given.
+func openSource(args *args, dir string) (*YamlSource, error) {
+	if args.rev != "" {
+		return RevSource(dir, args.rev)
 	}
+	return OpenSource(dir)
 }
 
 func printHelp() {
@@ -66,9 +127,11 @@ $ osch [command] [options]
 
 commands:
 
-  help  - prints this help
-  check - checks the schema
-  proto - converts the schema to ProtocolBuffers
+  help   - prints this help
+  check  - checks the schema
+  export - writes the resolved model to a JSON or YAML bundle
+  proto  - converts the schema to ProtocolBuffers
+  ts     - generates TypeScript types for the schema
 
   `)
 }
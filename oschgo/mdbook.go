@@ -2,9 +2,9 @@ package main
 
 import (
 	"bytes"
-	"io/ioutil"
+	"errors"
+	"io/fs"
 	"log"
-	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -17,7 +17,7 @@ type mdWriter struct {
 }
 
 func writeMarkdownBook(args *args) {
-	model, err := ReadYamlModel(args.yamlDir)
+	model, err := readModel(args)
 	check(err, "could not read YAML model")
 	target := args.target
 	mkdir(target)
@@ -30,11 +30,18 @@ func writeMarkdownBook(args *args) {
 
 func (w *mdWriter) writeBook() {
 
+	title := w.model.Manifest.Generators.MdBook.Title
+	if title == "" {
+		title = w.model.Manifest.Title()
+	}
+	if w.model.Profile != "" {
+		title += " (" + w.model.Profile + ")"
+	}
 	w.file("book.toml", `[book]
 language = "en"
 multilingual = false
 src = "src"
-title = "openLCA Schema"
+title = `+strconv.Quote(title)+`
 
 [output.html]
 mathjax-support = true
@@ -42,20 +49,12 @@ mathjax-support = true
 
 	w.dir("src")
 	w.file("src/SUMMARY.md", w.summary())
-
-	// try to copy the schema README and CHANGES
-	mds := []string{"README.md", "CHANGES.md"}
-	for _, md := range mds {
-		mdPath := filepath.Join(filepath.Dir(w.args.yamlDir), md)
-		if _, err := os.Stat(mdPath); err == nil {
-			if text, err := ioutil.ReadFile(mdPath); err == nil {
-				w.file("src/"+md, string(text))
-			} else {
-				log.Println("WARNING: failed to copy", mdPath)
-			}
-		}
+	if len(w.model.Overlays) > 0 {
+		w.file("src/overlays.md", w.docOverlays())
 	}
 
+	w.copyDocs()
+
 	w.dir("src/classes")
 	for _, t := range w.model.Types {
 		if t.IsEnum() {
@@ -74,6 +73,28 @@ mathjax-support = true
 
 }
 
+// Tries to copy the schema README and CHANGES from the parent folder of the
+// first input root, which can be in an archive or at a git revision. A bundle
+// does not contain these files, so nothing is copied for a bundle input.
+func (w *mdWriter) copyDocs() {
+	if isBundleInput(w.args) {
+		log.Println("No README and CHANGES are copied from a bundle")
+		return
+	}
+	parent, err := openSource(w.args, filepath.Dir(w.args.yamlDirs[0]))
+	if err != nil {
+		return
+	}
+	for _, md := range []string{"README.md", "CHANGES.md"} {
+		text, err := fs.ReadFile(parent.FS, md)
+		if err == nil {
+			w.file("src/"+md, string(text))
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			log.Println("WARNING: failed to copy", parent.PathOf(md))
+		}
+	}
+}
+
 func (w *mdWriter) dir(path string) string {
 	fullPath := filepath.Join(w.target, path)
 	mkdir(fullPath)
@@ -91,16 +112,24 @@ func (w *mdWriter) summary() string {
 	buff.Writeln("# Summary\n")
 	buff.Writeln("[Introduction](./README.md)")
 	buff.Writeln("[Changes](./CHANGES.md)")
+	if len(w.model.Overlays) > 0 {
+		buff.Writeln("[Overlays](./overlays.md)")
+	}
+
+	// types in namespaces are listed in their own sections
+	inDefault := func(name string) bool {
+		return w.model.TypeMap[name].Namespace == nil
+	}
 
 	buff.Writeln("# Root entities\n")
 	innerTypes := w.innerTypes()
 	w.model.EachClass(func(class *YamlClass) {
-		if !w.model.IsRoot(class) {
+		if !w.model.IsRoot(class) || !inDefault(class.Name) {
 			return
 		}
 		buff.Writeln(" - [" + class.Name + "](./classes/" + class.Name + ".md)")
 		w.model.EachClass(func(inner *YamlClass) {
-			if innerTypes[inner.Name] == class.Name {
+			if innerTypes[inner.Name] == class.Name && inDefault(inner.Name) {
 				buff.Writeln("   - [" + inner.Name + "](./classes/" +
 					inner.Name + ".md)\n")
 			}
@@ -109,12 +138,13 @@ func (w *mdWriter) summary() string {
 
 	buff.Writeln("# Other components\n")
 	w.model.EachClass(func(class *YamlClass) {
-		if w.model.IsRoot(class) || innerTypes[class.Name] != "" {
+		if w.model.IsRoot(class) || innerTypes[class.Name] != "" ||
+			!inDefault(class.Name) {
 			return
 		}
 		buff.Writeln(" - [" + class.Name + "](./classes/" + class.Name + ".md)")
 		w.model.EachClass(func(inner *YamlClass) {
-			if innerTypes[inner.Name] == class.Name {
+			if innerTypes[inner.Name] == class.Name && inDefault(inner.Name) {
 				buff.Writeln("   - [" + inner.Name + "](./classes/" +
 					inner.Name + ".md)\n")
 			}
@@ -123,19 +153,48 @@ func (w *mdWriter) summary() string {
 
 	buff.Writeln("\n# Enumerations\n")
 	for _, t := range w.model.Types {
-		if t.IsClass() {
+		if t.IsClass() || t.Namespace != nil {
 			continue
 		}
 		buff.Writeln(" - [" + t.Name() + "](./enums/" + t.Name() + ".md)")
 	}
 
+	// a section for each namespace with its classes and enumerations
+	for _, ns := range w.model.Namespaces() {
+		buff.Writeln("\n# " + ns.Section() + "\n")
+		for _, t := range w.model.Types {
+			if t.NamespaceName() != ns.Name {
+				continue
+			}
+			folder := "classes"
+			if t.IsEnum() {
+				folder = "enums"
+			}
+			buff.Writeln(" - [" + t.Name() + "](./" + folder + "/" +
+				t.Name() + ".md)")
+		}
+	}
+
 	return buff.String()
 }
 
 func (w *mdWriter) docClassOf(class *YamlClass) string {
 	var buff bytes.Buffer
-	buff.WriteString("# " + class.Name + "\n\n")
+	buff.WriteString("# " + mdTitleOf(class.Name, &class.YamlVersioning) + "\n\n")
+	buff.WriteString(mdVersionNoteOf(class.Name, &class.YamlVersioning))
 	buff.WriteString(class.Doc + "\n\n")
+	if origin := w.model.TypeMap[class.Name].Origin; origin != "" {
+		buff.WriteString("Defined in the overlay " + mdOverlayLinkOf(origin) +
+			".\n\n")
+	}
+	if mixins := w.model.MixinsOf(class); len(mixins) > 0 {
+		links := make([]string, 0, len(mixins))
+		for _, mixin := range mixins {
+			links = append(links, "["+mixin.Name+"](./"+mixin.Name+".md)")
+		}
+		buff.WriteString("Includes the mixins: " + strings.Join(links, ", ") +
+			"\n\n")
+	}
 
 	buff.WriteString("## Properties\n\n")
 
@@ -149,17 +208,17 @@ func (w *mdWriter) docClassOf(class *YamlClass) string {
 		parent = w.model.ParentOf(parent)
 	}
 
+	visited := make(map[*YamlClass]bool)
 	for _, p := range parents {
-		for _, prop := range p.Props {
-			buff.WriteString("### `" + prop.Name + "`\n\n")
-			buff.WriteString("Inherited from [" + p.Name + "." + prop.Name +
-				"](./" + p.Name + ".md#" + prop.Name + ")\n\n")
-			buff.WriteString(w.docPropOf(prop))
-		}
+		w.docInheritedPropsOf(&buff, p, "", visited)
+	}
+	for _, mixin := range w.model.MixinsOf(class) {
+		w.docInheritedPropsOf(&buff, mixin, "mixin ", visited)
 	}
 
 	for _, prop := range class.Props {
-		buff.WriteString("### `" + prop.Name + "`\n\n")
+		buff.WriteString("### " +
+			mdTitleOf("`"+prop.Name+"`", &prop.YamlVersioning) + "\n\n")
 		if prop.Doc != "" {
 			buff.WriteString(prop.Doc + "\n\n")
 		}
@@ -181,13 +240,33 @@ the names of the class and its properties.
 			continue
 		}
 		buff.WriteString("  " + prop.PyName() + ": " +
-			prop.PropType().ToPython() + "\n")
+			prop.PropType().ToPython(w.model) + "\n")
 	}
 	buff.WriteString("\n```\n")
 
 	return buff.String()
 }
 
+// Writes the documentation of the properties that are inherited from the given
+// class or mixin, including the properties of its mixins.
+func (w *mdWriter) docInheritedPropsOf(buff *bytes.Buffer, class *YamlClass,
+	kind string, visited map[*YamlClass]bool) {
+	if visited[class] {
+		return
+	}
+	visited[class] = true
+	for _, prop := range class.Props {
+		buff.WriteString("### " +
+			mdTitleOf("`"+prop.Name+"`", &prop.YamlVersioning) + "\n\n")
+		buff.WriteString("Inherited from " + kind + "[" + class.Name + "." +
+			prop.Name + "](./" + class.Name + ".md#" + prop.Name + ")\n\n")
+		buff.WriteString(w.docPropOf(prop))
+	}
+	for _, mixin := range w.model.MixinsOf(class) {
+		w.docInheritedPropsOf(buff, mixin, "mixin ", visited)
+	}
+}
+
 func (w *mdWriter) docPropOf(prop *YamlProp) string {
 	var buff bytes.Buffer
 	if prop.Required {
@@ -196,28 +275,146 @@ func (w *mdWriter) docPropOf(prop *YamlProp) string {
 		buff.WriteString("* _is optional_\n")
 	}
 	buff.WriteString("* _Type:_ " + w.docTypeOf(prop.Type) + "\n")
+	if def := prop.DefaultJSON(); def != "" {
+		buff.WriteString("* _Default:_ `" + def + "`\n")
+	}
+	if constraints := w.model.ConstraintsOf(prop).Describe(); len(constraints) > 0 {
+		buff.WriteString("* _Constraints:_\n")
+		for _, c := range constraints {
+			buff.WriteString("  * " + c + "\n")
+		}
+	}
 	buff.WriteString("* _Proto-Index:_ " + strconv.Itoa(prop.Index) + "\n")
+	if prop.Origin != "" {
+		buff.WriteString("* _Overlay:_ " + mdOverlayLinkOf(prop.Origin) + "\n")
+	}
+	buff.WriteString(mdVersionItemsOf(prop.Name, &prop.YamlVersioning))
 	return buff.String()
 }
 
 func (w *mdWriter) docEnumOf(enum *YamlEnum) string {
 	var buff bytes.Buffer
-	buff.WriteString("# " + enum.Name + "\n\n")
+	buff.WriteString("# " + mdTitleOf(enum.Name, &enum.YamlVersioning) + "\n\n")
+	buff.WriteString(mdVersionNoteOf(enum.Name, &enum.YamlVersioning))
 	buff.WriteString(enum.Doc + "\n\n")
+	if origin := w.model.TypeMap[enum.Name].Origin; origin != "" {
+		buff.WriteString("Defined in the overlay " + mdOverlayLinkOf(origin) +
+			".\n\n")
+	}
 
 	buff.WriteString("## Items\n\n")
 
 	for _, item := range enum.Items {
-		buff.WriteString("### `" + item.Name + "`\n\n")
+		buff.WriteString("### " +
+			mdTitleOf("`"+item.Name+"`", &item.YamlVersioning) + "\n\n")
 		if item.Doc != "" {
 			buff.WriteString(item.Doc + "\n\n")
 		}
 		buff.WriteString("* _Proto-Index:_ " + strconv.Itoa(item.Index) + "\n")
+		if item.Origin != "" {
+			buff.WriteString("* _Overlay:_ " + mdOverlayLinkOf(item.Origin) + "\n")
+		}
+		buff.WriteString(mdVersionItemsOf(item.Name, &item.YamlVersioning))
 	}
 
 	return buff.String()
 }
 
+// Returns the link to the section of the given overlay on the overlays page.
+func mdOverlayLinkOf(overlay string) string {
+	anchor := strings.ReplaceAll(strings.ToLower(overlay), " ", "-")
+	return "[" + overlay + "](../overlays.md#" + anchor + ")"
+}
+
+// Generates the page with the overlays of the schema and the elements they
+// add to the schema.
+func (w *mdWriter) docOverlays() string {
+	var buff bytes.Buffer
+	buff.WriteString("# Overlays\n\n")
+	buff.WriteString("Overlays extend the schema with additional types, " +
+		"properties, and enumeration items.\n\n")
+	for _, o := range w.model.Overlays {
+		buff.WriteString("## " + o.Name + "\n\n")
+		if o.Doc != "" {
+			buff.WriteString(o.Doc + "\n\n")
+		}
+		buff.WriteString("* _Index range:_ " + strconv.Itoa(o.MinIndex) +
+			" - " + strconv.Itoa(o.MaxIndex) + "\n")
+		// in a profile, the elements of pruned types are not listed
+		for _, t := range o.Types {
+			if w.model.TypeMap[t.Name()] == nil {
+				continue
+			}
+			folder := "classes"
+			if t.IsEnum() {
+				folder = "enums"
+			}
+			buff.WriteString("* _Type:_ [" + t.Name() + "](./" + folder + "/" +
+				t.Name() + ".md)\n")
+		}
+		for _, ext := range o.Extends {
+			if ext.Class != "" {
+				for _, prop := range ext.Props {
+					if !w.model.hasProp(ext.Class, prop) {
+						continue
+					}
+					buff.WriteString("* _Property:_ [" + ext.Class + "." + prop.Name +
+						"](./classes/" + ext.Class + ".md)\n")
+				}
+				continue
+			}
+			if w.model.TypeMap[ext.Enum] == nil {
+				continue
+			}
+			for _, item := range ext.Items {
+				buff.WriteString("* _Item:_ [" + ext.Enum + "." + item.Name +
+					"](./enums/" + ext.Enum + ".md)\n")
+			}
+		}
+		buff.WriteString("\n")
+	}
+	return buff.String()
+}
+
+// Returns the title of an element with a strike-through when it is deprecated
+// and badges for its versioning metadata.
+func mdTitleOf(title string, v *YamlVersioning) string {
+	if v.IsDeprecated() {
+		title = "~~" + title + "~~ " + mdBadgeOf("deprecated", "#c9302c")
+	}
+	if v.Since != "" {
+		title += " " + mdBadgeOf("since "+v.Since, "#5a6268")
+	}
+	return title
+}
+
+func mdBadgeOf(text, color string) string {
+	return "<span style=\"background-color: " + color + "; color: white; " +
+		"border-radius: 4px; padding: 1px 6px; font-size: 0.6em; " +
+		"vertical-align: middle;\">" + text + "</span>"
+}
+
+// Returns the deprecation note of a type as block quote.
+func mdVersionNoteOf(name string, v *YamlVersioning) string {
+	if !v.IsDeprecated() {
+		return ""
+	}
+	return "> **Deprecated:** " + v.DeprecationNote(name) + "\n\n"
+}
+
+// Returns the versioning metadata of a property or enumeration item as list
+// items.
+func mdVersionItemsOf(name string, v *YamlVersioning) string {
+	var buff bytes.Buffer
+	if v.Since != "" {
+		buff.WriteString("* _Since:_ " + v.Since + "\n")
+	}
+	if v.IsDeprecated() {
+		buff.WriteString("* _Deprecated:_ " + v.DeprecationNote("`"+name+"`") + "\n")
+	}
+	return buff.String()
+}
+
 func (w *mdWriter) docTypeOf(yamlType string) string {
 
 	if yamlType == "" {
@@ -231,16 +428,35 @@ func (w *mdWriter) docTypeOf(yamlType string) string {
 
 	if strings.HasPrefix(yamlType, "Ref[") {
 		unpacked := strings.TrimPrefix(strings.TrimSuffix(yamlType, "]"), "Ref[")
-		return "[Ref](./Ref.md) of " + w.docTypeOf(unpacked)
+		ref := w.model.RefClass().Name
+		link := "[" + ref + "](./" + ref + ".md)"
+		if w.model.Profile != "" && w.model.TypeMap[unpacked] == nil {
+			// the referenced type is not in the profile
+			return link + " of `" + unpacked + "`"
+		}
+		return link + " of " + w.docTypeOf(unpacked)
+	}
+
+	if strings.HasPrefix(yamlType, "Union[") {
+		alternatives := YamlPropType(yamlType).UnpackUnion()
+		docs := make([]string, 0, len(alternatives))
+		for _, alt := range alternatives {
+			docs = append(docs, w.docTypeOf(string(alt)))
+		}
+		return "`Union` of " + strings.Join(docs, " or ")
 	}
 
-	if yamlType == "GeoJSON" {
-		return "`GeoJSON` ([external doc](https://tools.ietf.org/html/rfc7946))"
+	if strings.HasPrefix(yamlType, "Map[") {
+		key, value := YamlPropType(yamlType).UnpackMap()
+		return "`Map` of " + w.docTypeOf(string(key)) +
+			" to " + w.docTypeOf(string(value))
 	}
 
-	if startsWithLower(yamlType) {
-		return "`" + yamlType +
-			"` ([external doc](http://www.w3.org/TR/xmlschema-2/#" + yamlType + "))"
+	if primitive := w.model.Primitives[yamlType]; primitive != nil {
+		if primitive.Link == "" {
+			return "`" + yamlType + "`"
+		}
+		return "`" + yamlType + "` ([external doc](" + primitive.Link + "))"
 	}
 
 	t := w.model.TypeMap[yamlType]
@@ -276,10 +492,21 @@ func (w *mdWriter) innerTypes() map[string]string {
 					propType = strings.TrimPrefix(
 						strings.TrimSuffix(propType, "]"), "List[")
 				}
+				if strings.HasPrefix(propType, "Map[") {
+					_, value := YamlPropType(propType).UnpackMap()
+					propType = string(value)
+				}
 				if strings.HasPrefix(propType, "Ref[") {
 					propType = strings.TrimPrefix(
 						strings.TrimSuffix(propType, "]"), "Ref[")
 				}
+				if strings.HasPrefix(propType, "Union[") {
+					for _, alt := range YamlPropType(propType).UnpackUnion() {
+						if string(alt) == inner.Name() {
+							return true
+						}
+					}
+				}
 				if propType == inner.Name() {
 					return true
 				}
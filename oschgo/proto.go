@@ -2,28 +2,51 @@ package main
 
 import (
 	"bytes"
+	"fmt"
+	"log"
+	"sort"
 	"strconv"
 	"strings"
 	"unicode"
 )
 
-// FileHeader is the file header that is written to the generated proto3 file.
-// This is the place where you want to define global options
-const FileHeader = `// Generated from olca-schema (https://github.com/GreenDelta/olca-schema).
-// DO NOT EDIT!
-
-syntax = "proto3";
-
-package protolca;
-
-option csharp_namespace = "ProtoLCA";
-option go_package = ".;protolca";
-option java_package = "org.openlca.proto";
-option java_outer_classname = "Proto";
-option java_multiple_files = true;
-
+// Generates the file header of the proto3 file of the given package from the
+// schema manifest. This is the place where the imports and the global options
+// are defined.
+func protoHeaderOf(manifest *YamlManifest, pkg string, imports []string) string {
+	var buff bytes.Buffer
+	buff.WriteString("// Generated from " + manifest.Title() +
+		" (" + manifest.BaseUrl + ").\n")
+	if manifest.License != "" {
+		buff.WriteString("// License: " + manifest.License + "\n")
+	}
+	buff.WriteString("// DO NOT EDIT!\n\n")
+	buff.WriteString("syntax = \"proto3\";\n\n")
 
-`
+	config := manifest.Generators.Proto
+	buff.WriteString("package " + pkg + ";\n\n")
+	for _, imp := range imports {
+		buff.WriteString("import \"" + imp + ".proto\";\n")
+	}
+	if len(imports) > 0 {
+		buff.WriteString("\n")
+	}
+	for _, option := range config.Options {
+		value := fmt.Sprint(option.Value)
+		if option.Key == "java_outer_classname" && pkg != config.Package {
+			// outer class names must be unique within a Java package
+			suffix := pkg[strings.LastIndex(pkg, ".")+1:]
+			value += strings.ToUpper(suffix[:1]) + suffix[1:]
+		}
+		if _, isString := option.Value.(string); isString {
+			value = strconv.Quote(value)
+		}
+		buff.WriteString("option " + fmt.Sprint(option.Key) +
+			" = " + value + ";\n")
+	}
+	buff.WriteString("\n\n")
+	return buff.String()
+}
 
 // BytesHint is a comment we add to fields with `bytes` as data type.
 const BytesHint = `  // When we map to the bytes type it means that we have no matching message
@@ -37,8 +60,7 @@ const BytesHint = `  // When we map to the bytes type it means that we have no m
 const FileFooter = `
 
 // We map the openLCA ModelType to this enumeration type because it is only
-// used in the categories. In order to be compatible with the JSON-LD '@type'
-// field we use the ProtoType enumeration type
+// used in the categories. The '@type' field of the messages is a string.
 enum ProtoCategoryType {
 
   UNDEFINED_CATEGORY_TYPE = 0;
@@ -59,56 +81,145 @@ enum ProtoCategoryType {
   UNIT_GROUP = 18;
 	RESULT = 19;
 }
+`
 
-// This enumeration type is added for compatibility with the @type attribute of
-// the openLCA JSON-LD format. In the proto messages we limit its usage to
-// instances of CategorizedEntity and Ref while it is allowed for every type in
-// the JSON-LD format. Thus, you should use ignoringUnknownFields flag when
-// parsing openLCA JSON-LD messages with the generated proto parsers.
-enum ProtoType {
-  Undefined = 0;
-  Actor = 1;
-  Category = 2;
-  Currency = 3;
-  DQSystem = 4;
-  Flow = 5;
-  FlowProperty = 6;
-  ImpactCategory = 7;
-  ImpactMethod = 8;
-  Location = 9;
-  NwSet = 10;
-  Parameter = 11;
-  Process = 12;
-  ProductSystem = 13;
-  Project = 14;
-	Result = 15;
-  SocialIndicator = 16;
-  Source = 17;
-  Unit = 18;
-  UnitGroup = 19;
+// ProtoFile is a generated proto3 file that contains the types of a package.
+type ProtoFile struct {
+	Name    string
+	Package string
+	Content string
+}
+
+// Generates the proto3 types of a package. Types of other packages are
+// referenced with their full name and the packages are collected as imports.
+type protoWriter struct {
+	model   *YamlModel
+	pkg     string
+	imports map[string]bool
+}
+
+// GenProto generates a proto3 file for each package of the schema. Types that
+// are not in a namespace are generated in the package of the manifest; this
+// file comes first and also contains the global type definitions. Returns an
+// error if the name of a property is not a valid proto3 name.
+func GenProto(yaml *YamlModel) ([]*ProtoFile, error) {
+	for _, t := range yaml.Types {
+		if !t.IsClass() {
+			continue
+		}
+		for _, prop := range t.Class.Props {
+			if err := prop.checkProtoName(); err != nil {
+				return nil, fmt.Errorf("property %s of class %s: %w",
+					prop.Name, t.Name(), err)
+			}
+		}
+		if t.Class.Abstract || t.Class.Annotations.Bool("x-proto-skip") {
+			continue
+		}
+		if _, err := yaml.protoNumbersOf(t.Class); err != nil {
+			return nil, err
+		}
+	}
+
+	mainPkg := yaml.Manifest.Generators.Proto.Package
+	packages := []string{mainPkg}
+	seen := map[string]bool{mainPkg: true}
+	for _, t := range yaml.Types {
+		pkg := t.Namespace.ProtoPackageOf(yaml.Manifest)
+		if !seen[pkg] {
+			seen[pkg] = true
+			packages = append(packages, pkg)
+		}
+	}
+
+	files := make([]*ProtoFile, 0, len(packages))
+	importsOf := make(map[string][]string)
+	for _, pkg := range packages {
+		w := &protoWriter{model: yaml, pkg: pkg, imports: map[string]bool{}}
+		body := w.genTypes()
+		if pkg == mainPkg {
+			body += FileFooter
+		}
+		imports := make([]string, 0, len(w.imports))
+		for imp := range w.imports {
+			imports = append(imports, imp)
+		}
+		sort.Strings(imports)
+		importsOf[pkg] = imports
+		files = append(files, &ProtoFile{
+			Name:    pkg + ".proto",
+			Package: pkg,
+			Content: protoHeaderOf(yaml.Manifest, pkg, imports) + body,
+		})
+	}
+	if err := checkProtoImports(packages, importsOf); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// Checks that the imports of the proto packages have no cycles, which protoc
+// does not allow. This happens when types of two namespaces reference each
+// other; such types have to be moved into the same namespace.
+func checkProtoImports(packages []string, importsOf map[string][]string) error {
+	done := make(map[string]bool)
+	var visit func(pkg string, path []string) error
+	visit = func(pkg string, path []string) error {
+		for i, other := range path {
+			if other == pkg {
+				return fmt.Errorf("cyclic imports of proto packages: %s",
+					strings.Join(append(path[i:], pkg), " -> "))
+			}
+		}
+		if done[pkg] {
+			return nil
+		}
+		for _, imp := range importsOf[pkg] {
+			if err := visit(imp, append(path, pkg)); err != nil {
+				return err
+			}
+		}
+		done[pkg] = true
+		return nil
+	}
+	for _, pkg := range packages {
+		if err := visit(pkg, nil); err != nil {
+			return err
+		}
+	}
+	return nil
 }
-`
 
-func GenProto(yaml *YamlModel) string {
+// Generates the message and enumeration types of the package of the writer.
+func (w *protoWriter) genTypes() string {
 	var buff bytes.Buffer
-	buff.WriteString(FileHeader)
+	yaml := w.model
 
 	// write the message and enumeration types
 	for _, typeDef := range yaml.Types {
-		switch typeDef.Name() {
-		case "Entity", "RootEntity", "CategorizedEntity":
+		if typeDef.Namespace.ProtoPackageOf(yaml.Manifest) != w.pkg {
 			continue
 		}
 
 		// write a class definition
 		class := typeDef.Class
 		if class != nil {
+			if class.Abstract || class.Annotations.Bool("x-proto-skip") {
+				// abstract classes and mixins are inlined
+				continue
+			}
 			comment := formatComment(class.Doc, "")
 			if comment != "" {
 				buff.WriteString(comment)
 			}
-			buff.WriteString("message Proto" + class.Name + " {\n\n")
-			writeProtoFields(class, &buff, yaml.TypeMap, 1)
+			buff.WriteString(formatComment(class.DeprecationNote(class.Name), ""))
+			buff.WriteString("message " +
+				protoNameOf(class.Name, class.Annotations) + " {\n\n")
+			if class.IsDeprecated() {
+				buff.WriteString("  option deprecated = true;\n\n")
+			}
+			numbers, _ := yaml.protoNumbersOf(class)
+			w.writeProps(yaml.protoPropsOf(class), numbers, &buff)
 			buff.WriteString("}\n\n")
 			continue
 		}
@@ -116,7 +227,7 @@ func GenProto(yaml *YamlModel) string {
 		// write an enumeration
 		enum := typeDef.Enum
 		if enum != nil {
-			if enum.Name == "ModelType" {
+			if enum.Annotations.Bool("x-proto-skip") {
 				continue
 			}
 			comment := formatComment(enum.Doc, "")
@@ -124,107 +235,237 @@ func GenProto(yaml *YamlModel) string {
 				buff.WriteString(comment)
 			}
 
-			buff.WriteString("enum Proto" + enum.Name + " {\n\n")
+			buff.WriteString(formatComment(enum.DeprecationNote(enum.Name), ""))
+			buff.WriteString("enum " +
+				protoNameOf(enum.Name, enum.Annotations) + " {\n\n")
+			if enum.IsDeprecated() {
+				buff.WriteString("  option deprecated = true;\n\n")
+			}
 
 			buff.WriteString("  // This default option was added automatically\n")
 			buff.WriteString("  // and means that no values was set.\n")
 			buff.WriteString("  " + protoUndefinedOf(enum) + " = 0;\n\n")
 			for i, item := range enum.Items {
+				if item.Annotations.Bool("x-proto-skip") {
+					continue
+				}
 				comment := formatComment(item.Doc, "  ")
 				if comment != "" {
 					buff.WriteString(comment)
 				}
-				buff.WriteString("  " + item.Name + " = " +
-					strconv.Itoa(i+1) + ";\n\n")
+				buff.WriteString(formatComment(item.DeprecationNote(item.Name), "  "))
+				name := item.Name
+				if n := item.Annotations.String("x-proto-name"); n != "" {
+					name = n
+				}
+				number := i + 1
+				if item.Origin != "" {
+					// items of overlays are numbered by their indices
+					number = item.Index
+				}
+				buff.WriteString("  " + name + " = " + strconv.Itoa(number) +
+					protoOptionsOf(&item.YamlVersioning) + ";\n\n")
 			}
 			buff.WriteString("}\n\n")
 		}
 	}
-
-	buff.WriteString(FileFooter)
 	return buff.String()
 }
 
-// Writes the fields of the given class to the given buffer. This function
-// climbs up the class hierarchy and inlines the fields of the corresponding
-// super classes (as there is no extension mechanism in proto3).
-func writeProtoFields(class *YamlClass, buff *bytes.Buffer, types map[string]*YamlType, offset int) int {
-	count := offset
+// Returns the properties of the fields of the message of the given class. The
+// fields of the super classes are inlined (as there is no extension mechanism
+// in proto3), starting with the root of the class hierarchy. The fields of the
+// mixins of the class and its super classes follow after the fields of the
+// class hierarchy, so that adding a mixin does not change the numbers of
+// existing fields; each mixin is included only once.
+func (model *YamlModel) protoPropsOf(class *YamlClass) []*YamlProp {
+	index := model.Index()
+	var props []*YamlProp
+	add := func(c *YamlClass) {
+		for _, prop := range c.Props {
+			if !prop.Annotations.Bool("x-proto-skip") {
+				props = append(props, prop)
+			}
+		}
+	}
+
+	var hierarchy []*YamlClass
+	for c := class; c != nil; c = index.ParentOf(c) {
+		hierarchy = append([]*YamlClass{c}, hierarchy...)
+	}
+	for _, c := range hierarchy {
+		add(c)
+	}
 
-	// write fields of super classes recursively
-	if class.SuperClass != "" {
-		super := types[class.SuperClass]
-		if super != nil && super.Class != nil {
-			count = writeProtoFields(super.Class, buff, types, offset)
+	visited := make(map[*YamlClass]bool)
+	var addMixins func(c *YamlClass)
+	addMixins = func(c *YamlClass) {
+		for _, mixin := range index.MixinsOf(c) {
+			if visited[mixin] {
+				continue
+			}
+			visited[mixin] = true
+			add(mixin)
+			addMixins(mixin)
 		}
 	}
+	for _, c := range hierarchy {
+		addMixins(c)
+	}
+	return props
+}
 
-	// @type field
-	if class.Name == "Ref" || class.Name == "CategorizedEntity" {
-		buff.WriteString("  // The type name of the respective entity.\n")
-		buff.WriteString("  ProtoType type = " + strconv.Itoa(count))
-		buff.WriteString(" [json_name = \"@type\"];\n\n")
-		count++
+// Returns the field numbers of the properties in the message of the given
+// class. The fields are numbered consecutively in the order of protoPropsOf,
+// except for the fields of overlays which are numbered by their indices so
+// that the field numbers of the base schema do not change. A union property
+// has a field for each alternative; the returned number is the number of the
+// first alternative and the other alternatives follow consecutively. Returns
+// an error if a field number is used twice.
+func (model *YamlModel) protoNumbersOf(class *YamlClass) (map[*YamlProp]int, error) {
+	numbers := make(map[*YamlProp]int)
+	used := make(map[int]*YamlProp)
+	next := 1
+	for _, prop := range model.protoPropsOf(class) {
+		number := next
+		if prop.Origin != "" {
+			number = prop.Index
+		} else {
+			next += protoWidthOf(prop)
+		}
+		numbers[prop] = number
+		for i := 0; i < protoWidthOf(prop); i++ {
+			if other := used[number+i]; other != nil {
+				return nil, fmt.Errorf("field number %d of property %s in the "+
+					"message of class %s is already used by property %s",
+					number+i, prop.Name, class.Name, other.Name)
+			}
+			used[number+i] = prop
+		}
 	}
+	return numbers, nil
+}
 
-	// ID field
-	if class.Name == "RootEntity" {
-		buff.WriteString("  // The reference ID (typically an UUID) of the entity.\n")
-		buff.WriteString("  string id = " + strconv.Itoa(count))
-		buff.WriteString(" [json_name = \"@id\"];\n\n")
-		count++
+// Returns the number of fields of the given property in proto3: the number of
+// alternatives of a union type, or 1 otherwise.
+func protoWidthOf(prop *YamlProp) int {
+	if propType := prop.PropType(); propType.IsUnion() {
+		return len(propType.UnpackUnion())
 	}
+	return 1
+}
 
-	// write fields
-	for _, field := range class.Props {
+// Writes the given properties as fields with the given field numbers.
+func (w *protoWriter) writeProps(props []*YamlProp, numbers map[*YamlProp]int, buff *bytes.Buffer) {
+	model := w.model
+	for _, field := range props {
 
 		// field comment
 		comment := formatComment(field.Doc, "  ")
 		if comment != "" {
 			buff.WriteString(comment)
 		}
+		if constraints := model.ConstraintsOf(field).Describe(); len(constraints) > 0 {
+			buff.WriteString(formatComment(
+				"Constraints: "+strings.Join(constraints, "; "), "  "))
+		}
+
+		number := func(offset int) string {
+			return strconv.Itoa(numbers[field] + offset)
+		}
 
-		protoType := toProtoType(field.Type)
-		protoField := toSnakeCase(field.Name)
+		// a union type is mapped to a `oneof` with a field for each alternative
+		if propType := field.PropType(); propType.IsUnion() {
+			buff.WriteString(formatComment(field.DeprecationNote(field.Name), "  "))
+			protoField := field.ProtoName()
+			buff.WriteString("  oneof " + protoField + " {\n")
+			for i, alt := range propType.UnpackUnion() {
+				buff.WriteString("    " + w.typeOf(string(alt)) + " " +
+					protoField + "_" + toSnakeCase(string(alt)) + " = " +
+					number(i) + protoOptionsOf(&field.YamlVersioning) + ";\n")
+			}
+			buff.WriteString("  }\n\n")
+			continue
+		}
+
+		protoType := w.typeOf(field.Type)
+		protoField := field.ProtoName()
+		var options []string
+		if protoField != toSnakeCase(field.Name) {
+			// keep the JSON name of the field compatible with the schema
+			options = append(options, "json_name = "+strconv.Quote(field.Name))
+		}
 		if protoType == "bytes" {
 			buff.WriteString(BytesHint)
 			protoField += "_bytes"
 		}
 
+		buff.WriteString(formatComment(field.DeprecationNote(field.Name), "  "))
 		buff.WriteString("  " + protoType + " " + protoField +
-			" = " + strconv.Itoa(count) + ";\n\n")
-		count++
+			" = " + number(0) +
+			protoOptionsOf(&field.YamlVersioning, options...) + ";\n\n")
 	}
+}
+
+// Returns the field options, like `[deprecated = true]`, of a field or enum
+// value with the given versioning metadata and additional options.
+func protoOptionsOf(v *YamlVersioning, options ...string) string {
+	if v.IsDeprecated() {
+		options = append(options, "deprecated = true")
+	}
+	if len(options) == 0 {
+		return ""
+	}
+	return " [" + strings.Join(options, ", ") + "]"
+}
 
-	return count
+// Returns the name of the message or enumeration type of the given schema
+// type in the proto3 output.
+func protoNameOf(name string, annotations YamlAnnotations) string {
+	if protoName := annotations.String("x-proto-name"); protoName != "" {
+		return protoName
+	}
+	return "Proto" + name
 }
 
 // Maps the given olca-schema type to a corresponding proto3 type.
-func toProtoType(schemaType string) string {
-	switch schemaType {
-	case "string", "double", "float":
-		return schemaType
-	case "dateTime", "date":
-		return "string"
-	case "int", "integer":
-		return "int32"
-	case "boolean":
-		return "bool"
-	case "GeoJSON":
+func (w *protoWriter) typeOf(schemaType string) string {
+	primitives := w.model.Primitives
+	if primitives.Has(schemaType) {
+		if protoType := primitives.TargetOf(schemaType, "proto"); protoType != "" {
+			return protoType
+		}
+		log.Println("WARNING: no proto type defined for primitive:", schemaType)
 		return "bytes"
-	case "ModelType":
-		return "ProtoCategoryType"
 	}
 
 	if strings.HasPrefix(schemaType, "Ref[") {
-		return "ProtoRef"
+		return w.typeOf(w.model.RefClass().Name)
 	}
 	if strings.HasPrefix(schemaType, "List[") {
 		t := strings.TrimSuffix(
 			strings.TrimPrefix(schemaType, "List["), "]")
-		return "repeated " + toProtoType(t)
+		return "repeated " + w.typeOf(t)
+	}
+	if strings.HasPrefix(schemaType, "Map[") {
+		key, value := YamlPropType(schemaType).UnpackMap()
+		return "map<" + w.typeOf(string(key)) + ", " + w.typeOf(string(value)) + ">"
 	}
 
+	if t := w.model.TypeMap[schemaType]; t != nil {
+		var name string
+		if t.IsClass() {
+			name = protoNameOf(schemaType, t.Class.Annotations)
+		} else {
+			name = protoNameOf(schemaType, t.Enum.Annotations)
+		}
+		pkg := t.Namespace.ProtoPackageOf(w.model.Manifest)
+		if pkg == w.pkg {
+			return name
+		}
+		w.imports[pkg] = true
+		return pkg + "." + name
+	}
 	return "Proto" + schemaType
 }
 
@@ -0,0 +1,299 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+// Reads a model from the given YAML files, given as `name: content` pairs.
+func readTestModel(t *testing.T, files map[string]string) (*YamlModel, error) {
+	t.Helper()
+	fsys := fstest.MapFS{}
+	for name, content := range files {
+		fsys[name] = &fstest.MapFile{Data: []byte(content)}
+	}
+	return ReadYamlSources(&YamlSource{Name: "test", FS: fsys})
+}
+
+// Generates the proto3 file of the main package of the given model.
+func protoOf(t *testing.T, model *YamlModel) string {
+	t.Helper()
+	files, err := GenProto(model)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files[0].Content
+}
+
+// The test schema: `Unit` includes `MixA` and `MixB`, and `MixB` includes
+// `MixA` again.
+var mixinTestFiles = map[string]string{
+	"Unit.yaml": `class:
+  name: Unit
+  mixins: [MixA, MixB]
+  properties:
+  - name: name
+    type: string
+  - name: factor
+    type: double
+`,
+	"MixA.yaml": `class:
+  name: MixA
+  abstract: true
+  properties:
+  - name: tagA
+    type: string
+`,
+	"MixB.yaml": `class:
+  name: MixB
+  abstract: true
+  mixins: [MixA]
+  properties:
+  - name: tagB
+    type: string
+`,
+}
+
+func TestProtoMixinFields(t *testing.T) {
+	model, err := readTestModel(t, mixinTestFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proto := protoOf(t, model)
+	for _, field := range []string{
+		"string name = 1;",
+		"double factor = 2;",
+		"string tag_a = 3;",
+		"string tag_b = 4;",
+	} {
+		if !strings.Contains(proto, field) {
+			t.Error("missing field:", field)
+		}
+	}
+	if strings.Count(proto, "tag_a") != 1 {
+		t.Error("the fields of a mixin should be included only once")
+	}
+}
+
+func TestCyclicMixins(t *testing.T) {
+	files := make(map[string]string)
+	for name, content := range mixinTestFiles {
+		files[name] = content
+	}
+	files["MixA.yaml"] = strings.Replace(files["MixA.yaml"],
+		"abstract: true", "abstract: true\n  mixins: [MixB]", 1)
+	if _, err := readTestModel(t, files); err == nil ||
+		!strings.Contains(err.Error(), "cyclic mixins") {
+		t.Error("expected an error for cyclic mixins, got:", err)
+	}
+}
+
+func TestMixinNameClash(t *testing.T) {
+	files := make(map[string]string)
+	for name, content := range mixinTestFiles {
+		files[name] = content
+	}
+	files["MixB.yaml"] = strings.Replace(files["MixB.yaml"],
+		"name: tagB", "name: factor", 1)
+	if _, err := readTestModel(t, files); err == nil ||
+		!strings.Contains(err.Error(), "property factor of mixin MixB") {
+		t.Error("expected an error for a mixin property with the name of "+
+			"a class property, got:", err)
+	}
+}
+
+// The `@type` and `@id` fields are named by annotations or, without
+// annotations, by their default names.
+func TestProtoNames(t *testing.T) {
+	for _, annotation := range []string{"x-proto-name", "x-ignored"} {
+		model, err := readTestModel(t, map[string]string{
+			"Entity.yaml": `class:
+  name: Entity
+  x-proto-skip: true
+  properties:
+  - name: '@type'
+    type: string
+    ` + annotation + `: type
+`,
+			"Unit.yaml": `class:
+  name: Unit
+  superClass: Entity
+  properties:
+  - name: '@id'
+    type: string
+    ` + annotation + `: id
+  - name: name
+    type: string
+`,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		proto := protoOf(t, model)
+		for _, field := range []string{
+			`string type = 1 [json_name = "@type"];`,
+			`string id = 2 [json_name = "@id"];`,
+			"string name = 3;",
+		} {
+			if !strings.Contains(proto, field) {
+				t.Error(annotation, "missing field:", field)
+			}
+		}
+		if strings.Count(proto, " id = ") != 1 {
+			t.Error("the ID field should be written only once")
+		}
+	}
+}
+
+func TestInvalidNames(t *testing.T) {
+	model, err := readTestModel(t, map[string]string{
+		"Unit.yaml": `class:
+  name: Unit
+  properties:
+  - name: '@context'
+    type: string
+`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := GenProto(model); err == nil {
+		t.Error("expected an error for an invalid proto3 name")
+	}
+	if _, err := pyClassesOf(model); err == nil {
+		t.Error("expected an error for an invalid Python name")
+	}
+}
+
+func TestNestedContainerTypes(t *testing.T) {
+	for _, propType := range []string{
+		"Map[string, List[string]]",
+		"Map[string, Map[string, string]]",
+		"List[Map[string, string]]",
+		"List[List[string]]",
+	} {
+		_, err := readTestModel(t, map[string]string{
+			"Unit.yaml": `class:
+  name: Unit
+  properties:
+  - name: values
+    type: ` + propType + "\n",
+		})
+		if err == nil {
+			t.Error("expected an error for type", propType)
+		}
+	}
+}
+
+// Types of two namespaces that reference each other would result in proto
+// packages that import each other.
+func TestProtoImportCycle(t *testing.T) {
+	files := map[string]string{
+		ManifestFile: `name: test
+generators:
+  proto:
+    package: test
+`,
+		"Flow.yaml": `class:
+  name: Flow
+  properties:
+  - name: property
+    type: FlowProperty
+    index: 1
+`,
+		"ext/" + NamespaceFile: "name: ext\n",
+		"ext/FlowProperty.yaml": `class:
+  name: FlowProperty
+  properties:
+  - name: name
+    type: string
+    index: 1
+`,
+	}
+	model, err := readTestModel(t, files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := GenProto(model); err != nil {
+		t.Fatal("imports in one direction should work:", err)
+	}
+
+	files["ext/FlowProperty.yaml"] += `  - name: flow
+    type: Flow
+    index: 2
+`
+	model, err = readTestModel(t, files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = GenProto(model)
+	if err == nil || !strings.Contains(err.Error(), "test -> test.ext -> test") {
+		t.Error("expected an error for cyclic imports, got", err)
+	}
+}
+
+// The properties of an overlay are numbered by their indices; the alternatives
+// of a union take the following numbers.
+func TestProtoOverlayNumbers(t *testing.T) {
+	files := map[string]string{
+		"Flow.yaml": `class:
+  name: Flow
+  properties:
+  - name: name
+    type: string
+    index: 1
+  - name: source
+    type: Union[Flow, Unit]
+    index: 2
+`,
+		"Unit.yaml": `class:
+  name: Unit
+  properties:
+  - name: name
+    type: string
+    index: 1
+`,
+		"acme/Acme.yaml": `overlay:
+  name: acme
+  minIndex: 100
+  maxIndex: 199
+  extends:
+  - class: Flow
+    properties:
+    - name: acmeSource
+      type: Union[Flow, Unit]
+      index: 100
+    - name: acmeId
+      type: string
+      index: 102
+`,
+	}
+	model, err := readTestModel(t, files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proto := protoOf(t, model)
+	for _, field := range []string{
+		"string name = 1;",
+		"ProtoFlow source_flow = 2;",
+		"ProtoUnit source_unit = 3;",
+		"ProtoFlow acme_source_flow = 100;",
+		"ProtoUnit acme_source_unit = 101;",
+		"string acme_id = 102;",
+	} {
+		if !strings.Contains(proto, field) {
+			t.Error("missing field in proto3 output:", field)
+		}
+	}
+
+	files["acme/Acme.yaml"] = strings.Replace(
+		files["acme/Acme.yaml"], "index: 102", "index: 101", 1)
+	model, err = readTestModel(t, files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := GenProto(model); err == nil {
+		t.Error("expected an error for a field number that is used twice")
+	}
+}
@@ -4,12 +4,16 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 )
 
 type pyWriter struct {
-	buff  *bytes.Buffer
-	model *YamlModel
+	buff    *bytes.Buffer
+	model   *YamlModel
+	classes []*YamlClass
 }
 
 // indentation levels
@@ -18,53 +22,194 @@ const pyInd2 = pyInd1 + pyInd1
 const pyInd3 = pyInd1 + pyInd1 + pyInd1
 
 func writePythonModule(args *args) {
-	model, err := ReadYamlModel(args.yamlDir)
+	model, err := readModel(args)
 	check(err, "could not read YAML model")
 
+	classes, err := pyClassesOf(model)
+	check(err, "could not generate the Python classes")
+
 	var buffer bytes.Buffer
 	writer := pyWriter{
-		buff:  &buffer,
-		model: model,
+		buff:    &buffer,
+		model:   model,
+		classes: classes,
+	}
+	switch args.flavor {
+	case "", "dataclass", "dataclasses":
+		writer.writeModel()
+	case "pydantic":
+		if args.format == "package" {
+			fmt.Println("ERROR: the pydantic flavor is only available as module")
+			return
+		}
+		if args.tests != "" {
+			fmt.Println("ERROR: tests are only generated for the dataclass flavor")
+			return
+		}
+		writer.writePydanticModel()
+	default:
+		fmt.Println("ERROR: unknown Python flavor:", args.flavor)
+		return
 	}
-	writer.writeModel()
 
-	if args.target != "" {
-		writeFile(args.target, buffer.String())
-	} else {
+	if args.format == "package" {
+		writer.writePackage(args.target)
+		if args.tests != "" && args.target != "" {
+			writer.writeTests(args.tests, writer.packageName()+".schema")
+		}
+		return
+	}
+	if args.target == "" {
 		fmt.Println(buffer.String())
+		return
+	}
+	writeFile(args.target, buffer.String())
+	dir, file := filepath.Split(args.target)
+	writer.writeModules(dir, strings.TrimSuffix(file, ".py"), false)
+	if args.tests != "" {
+		writer.writeTests(args.tests, pyImportNameOf(args.target))
 	}
 }
 
-func (w *pyWriter) writeModel() {
+// Writes the modules of the namespaces and the zip module next to the main
+// module into the given folder, optionally with typing stubs.
+func (w *pyWriter) writeModules(dir, mainModule string, withStubs bool) {
+
+	// write a module for each namespace next to the generated module that
+	// re-exports the types of that namespace
+	for _, ns := range w.model.Namespaces() {
+		module := ns.PyModule()
+		if module == mainModule {
+			log.Println("WARNING: the Python module of namespace", ns.Name,
+				"has the same name as the generated module")
+			continue
+		}
+		writeFile(filepath.Join(dir, module+".py"),
+			w.namespaceModuleOf(ns, mainModule))
+	}
+
+	// the zip module, if there are root entities
+	if !w.hasRoots() || mainModule == "zipio" {
+		return
+	}
+	zipModule := w.zipModuleOf(mainModule, false)
+	writeFile(filepath.Join(dir, "zipio.py"), zipModule)
+	if withStubs {
+		writeFile(filepath.Join(dir, "zipio.pyi"), w.zipModuleOf(mainModule, true))
+	}
+}
+
+func (w *pyWriter) hasRoots() bool {
+	hasRoots := false
+	w.model.EachClass(func(class *YamlClass) {
+		hasRoots = hasRoots || w.model.IsRoot(class)
+	})
+	return hasRoots
+}
+
+// Generates the module of the given namespace that imports the types of that
+// namespace from the main module.
+func (w *pyWriter) namespaceModuleOf(ns *YamlNamespace, mainModule string) string {
+	var names []string
+	for _, t := range w.model.Types {
+		if t.NamespaceName() != ns.Name ||
+			(t.IsClass() && w.model.IsAbstract(t.Class)) {
+			continue
+		}
+		names = append(names, t.Name())
+	}
+	b := NewBuffer()
+	b.Writeln("# DO NOT CHANGE THIS CODE AS THIS IS GENERATED AUTOMATICALLY")
+	b.Writeln()
+	b.Writeln("# This module contains the types of the `" + ns.Name +
+		"` namespace of the schema.")
+	b.Writeln()
+	b.Writeln("from ." + mainModule + " import (")
+	for _, name := range names {
+		b.Writeln(pyInd1 + name + ",")
+	}
+	b.Writeln(")")
+	b.Writeln()
+	b.Writeln("__all__ = [")
+	for _, name := range names {
+		b.Writeln(pyInd1 + "'" + name + "',")
+	}
+	b.Writeln("]")
+	return b.String()
+}
 
+// Writes the header comment of the generated module.
+func (w *pyWriter) writeHeader() {
+	manifest := w.model.Manifest
 	w.writeln("# DO NOT CHANGE THIS CODE AS THIS IS GENERATED AUTOMATICALLY")
 	w.writeln(`
 # This module contains a Python API for reading and writing data sets in
 # the JSON based openLCA data exchange format. For more information see
-# http://greendelta.github.io/olca-schema
-`)
+# ` + manifest.BaseUrl)
+	if manifest.Version != "" {
+		w.writeln("#")
+		w.writeln("# Schema version: " + manifest.Version)
+	}
+	if manifest.License != "" {
+		w.writeln("# License: " + manifest.License)
+	}
+	w.writeln()
+}
+
+func (w *pyWriter) writeModel() {
+	manifest := w.model.Manifest
+	w.writeHeader()
 
-	// imports
+	// imports; annotations are not evaluated, so that the type hints can
+	// point to classes that are defined later in the module
+	w.writeln("from __future__ import annotations")
+	w.writeln()
 	w.writeln("import datetime")
 	w.writeln("import json")
+	w.writeln("import re")
 	w.writeln("import uuid")
+	w.writeln("import warnings")
+	w.writeln()
+	if len(w.deprecatedItems()) > 0 {
+		w.writeln("from enum import Enum, EnumMeta")
+	} else {
+		w.writeln("from enum import Enum")
+	}
+	if w.hasDeprecatedFields() {
+		w.writeln("from dataclasses import dataclass, field, fields")
+	} else {
+		w.writeln("from dataclasses import dataclass, field")
+	}
+	w.writeln("from typing import Any, Dict, Generic, List, Optional, TypeVar, Union")
 	w.writeln()
-	w.writeln("from enum import Enum")
-	w.writeln("from dataclasses import dataclass")
-	w.writeln("from typing import Any, Dict, List, Optional, Union")
 	w.writeln()
+	w.writeln("SCHEMA_VERSION = " + pyStringOf(manifest.Version))
 	w.writeln()
+	if w.model.RefClass() != nil {
+		// the type parameter of references
+		w.writeln("_T = TypeVar('_T')")
+		w.writeln()
+	}
+	w.writeln()
+	if manifest.Generators.Python.ParseDates {
+		w.writeDateFunctions()
+	}
+	w.writeCheckFunction()
+	if w.hasDeprecatedFields() {
+		w.writeValuesFunction()
+	}
 
 	// enums and classes
+	w.writeDeprecatedItems()
 	w.model.EachEnum(w.writeEnum)
-	for _, class := range topoSortClasses(w.model) {
-		if w.model.IsAbstract(class) {
-			continue
-		}
+	for _, class := range w.classes {
 		w.writeln(w.model.ToPyClass(class))
 	}
 
-	// write RootEntity type
+	// write RootEntity type; an empty union is not valid
+	if !w.hasRoots() {
+		return
+	}
 	w.writeln("RootEntity = Union[")
 	w.model.EachClass(func(class *YamlClass) {
 		if w.model.IsRoot(class) {
@@ -75,11 +220,135 @@ func (w *pyWriter) writeModel() {
 }
 
 func (w *pyWriter) writeEnum(enum *YamlEnum) {
-	w.writeln("class", enum.Name+"(Enum):")
+	if w.deprecatedItems()[enum.Name] != nil {
+		w.writeln("class", enum.Name+"(Enum, metaclass=_DeprecatedItems):")
+	} else {
+		w.writeln("class", enum.Name+"(Enum):")
+	}
+	w.write(pyDocstringOf(enum.Doc, pyInd1))
 	w.writeln()
 	for _, item := range enum.Items {
 		w.writeln(pyInd1 + item.Name + " = '" + item.Name + "'")
+		w.write(pyDocstringOf(item.Doc, pyInd1))
+	}
+	w.writeln()
+	w.writeln()
+}
+
+// Returns the deprecation notes of the deprecated enumeration items as map
+// `enum -> item -> note`. All items of a deprecated enumeration are deprecated.
+func (w *pyWriter) deprecatedItems() map[string]map[string]string {
+	notes := make(map[string]map[string]string)
+	w.model.EachEnum(func(enum *YamlEnum) {
+		for _, item := range enum.Items {
+			note := item.DeprecationNote(enum.Name + "." + item.Name)
+			if note == "" {
+				note = enum.DeprecationNote(enum.Name)
+			}
+			if note == "" {
+				continue
+			}
+			if notes[enum.Name] == nil {
+				notes[enum.Name] = make(map[string]string)
+			}
+			notes[enum.Name][item.Name] = note
+		}
+	})
+	return notes
+}
+
+// Writes the functions that convert `dateTime` values from and to strings. A
+// `Z` suffix is used for UTC as `datetime.fromisoformat` does not accept it in
+// older Python versions.
+func (w *pyWriter) writeDateFunctions() {
+	w.writeln("def _parse_datetime(s: str) -> datetime.datetime:")
+	w.writeln(pyInd1 + "if s.endswith('Z'):")
+	w.writeln(pyInd2 + "s = s[:-1] + '+00:00'")
+	w.writeln(pyInd1 + "return datetime.datetime.fromisoformat(s)")
+	w.writeln()
+	w.writeln()
+	w.writeln("def _format_datetime(dt: datetime.datetime) -> str:")
+	w.writeln(pyInd1 + "s = dt.isoformat()")
+	w.writeln(pyInd1 + "if s.endswith('+00:00'):")
+	w.writeln(pyInd2 + "s = s[:-6] + 'Z'")
+	w.writeln(pyInd1 + "return s")
+	w.writeln()
+	w.writeln()
+}
+
+// Writes the function that checks the type of a value in the `validate`
+// methods. Objects of the schema are validated recursively.
+func (w *pyWriter) writeCheckFunction() {
+	w.writeln("def _check_value(value: Any, types: Any, type_name: str, path: str,")
+	w.writeln("                 errors: List[str]) -> bool:")
+	w.writeln(pyInd1 + "valid = isinstance(value, types)")
+	w.writeln(pyInd1 + "if isinstance(value, bool):")
+	w.writeln(pyInd2 + "# a bool is an int in Python but not a valid number")
+	w.writeln(pyInd2 + "valid = types is bool")
+	w.writeln(pyInd1 + "if not valid:")
+	w.writeln(pyInd2 + "errors.append(f'{path}: expected {type_name} but got {value!r}')")
+	w.writeln(pyInd2 + "return False")
+	w.writeln(pyInd1 + "if hasattr(value, 'validate'):")
+	w.writeln(pyInd2 + "for error in value.validate():")
+	w.writeln(pyInd3 + "errors.append(f'{path}.{error}')")
+	w.writeln(pyInd1 + "return True")
+	w.writeln()
+	w.writeln()
+}
+
+// Returns true if a class of the model has deprecated properties.
+func (w *pyWriter) hasDeprecatedFields() bool {
+	for _, class := range w.classes {
+		for _, prop := range w.model.AllPropsOf(class) {
+			if prop.IsDeprecated() {
+				return true
+			}
+		}
 	}
+	return false
+}
+
+// Writes the function that reads the fields of an object for the `__eq__` and
+// `__repr__` methods of classes with deprecated fields.
+func (w *pyWriter) writeValuesFunction() {
+	w.writeln("def _values_of(obj: Any) -> Dict[str, Any]:")
+	w.writeln(pyInd1 + "# reads the fields without the deprecation warnings of __getattribute__")
+	w.writeln(pyInd1 + "return {f.name: object.__getattribute__(obj, f.name) for f in fields(obj)}")
+	w.writeln()
+	w.writeln()
+}
+
+// Writes the meta class that emits warnings when deprecated enumeration items
+// are accessed, if there are any.
+func (w *pyWriter) writeDeprecatedItems() {
+	notes := w.deprecatedItems()
+	if len(notes) == 0 {
+		return
+	}
+	w.writeln("_DEPRECATED_ITEMS: Dict[str, Dict[str, str]] = {")
+	w.model.EachEnum(func(enum *YamlEnum) {
+		items := notes[enum.Name]
+		if items == nil {
+			return
+		}
+		w.writeln(pyInd1 + "'" + enum.Name + "': {")
+		for _, item := range enum.Items {
+			if note, ok := items[item.Name]; ok {
+				w.writeln(pyInd2 + "'" + item.Name + "': " + pyStringOf(note) + ",")
+			}
+		}
+		w.writeln(pyInd1 + "},")
+	})
+	w.writeln("}")
+	w.writeln()
+	w.writeln()
+	w.writeln("class _DeprecatedItems(EnumMeta):")
+	w.writeln()
+	w.writeln(pyInd1 + "def __getattribute__(cls, name: str) -> Any:")
+	w.writeln(pyInd2 + "items = _DEPRECATED_ITEMS.get(type.__getattribute__(cls, '__name__'))")
+	w.writeln(pyInd2 + "if items is not None and name in items:")
+	w.writeln(pyInd3 + "warnings.warn(items[name], DeprecationWarning, stacklevel=2)")
+	w.writeln(pyInd2 + "return super().__getattribute__(name)")
 	w.writeln()
 	w.writeln()
 }
@@ -87,64 +356,122 @@ func (w *pyWriter) writeEnum(enum *YamlEnum) {
 func (model *YamlModel) ToPyClass(class *YamlClass) string {
 	b := NewBuffer()
 	b.Writeln("@dataclass")
-	b.Writeln("class", class.Name+":")
+	isRef := class.IsRef()
+	b.Writeln(pyClassHeaderOf(class))
+	b.buff.WriteString(pyDocstringOf(class.Doc, pyInd1))
 	b.Writeln()
 
 	// properties
 	props := model.AllPropsOf(class)
-	for _, prop := range props {
-		if prop.Name == "@type" {
-			continue
+	var required []*YamlProp
+	for _, f := range model.pyFieldsOf(class) {
+		init := f.init
+		if init == "" {
+			required = append(required, f.prop)
+			init = "field(kw_only=True)"
 		}
-		propType := YamlPropType(prop.Type)
-		b.Writeln(pyInd1 + prop.PyName() +
-			": Optional[" + propType.ToPython() + "] = None")
+		b.Writeln(pyInd1 + f.prop.PyName() + ": " + f.pyType + " = " + init)
+		b.buff.WriteString(pyDocstringOf(f.prop.Doc, pyInd1))
 	}
-	if class.Name == "Ref" {
-		b.Writeln("    model_type: str = ''")
+	typeField := class.Annotations.String("x-python-type-field")
+	if typeField != "" {
+		b.Writeln(pyInd1 + typeField + ": str = ''")
 	}
 	b.Writeln()
 
 	// __post_init__
-	if model.IsRoot(class) {
-		fields := []string{"id", "version", "last_change"}
-		inits := []string{"str(uuid.uuid4())", "'01.00.000'",
-			"datetime.datetime.utcnow().isoformat() + 'Z'"}
-		b.Writeln(pyInd1 + "def __post_init__(self):")
-		for i, field := range fields {
-			b.Writeln(pyInd2 + "if self." + field + " is None:")
-			b.Writeln(pyInd3 + "self." + field + " = " + inits[i])
+	if model.IsRoot(class) || class.IsDeprecated() {
+		b.Writeln(pyInd1 + "def __post_init__(self) -> None:")
+		if class.IsDeprecated() {
+			b.Writeln(pyInd2 + "warnings.warn(" +
+				pyStringOf(class.DeprecationNote(class.Name)) +
+				", DeprecationWarning, stacklevel=3)")
+		}
+		if model.IsRoot(class) {
+			init := "datetime.datetime.utcnow().isoformat() + 'Z'"
+			for _, prop := range props {
+				if prop.PyName() == "last_change" &&
+					model.pyDateKindOf(prop.PropType()) == "dateTime" {
+					init = "datetime.datetime.now(datetime.timezone.utc)"
+				}
+				if prop.PyName() == "version" {
+					// the initial version, also when the schema has no default
+					// value for it
+					version := "'01.00.000'"
+					if prop.Default != nil {
+						version = model.pyLiteralOf(prop.PropType(), prop.Default)
+					}
+					b.Writeln(pyInd2 + "if self.version is None:")
+					b.Writeln(pyInd3 + "self.version = " + version)
+				}
+			}
+			b.Writeln(pyInd2 + "if self.last_change is None:")
+			b.Writeln(pyInd3 + "self.last_change = " + init)
+		}
+		b.Writeln()
+	}
+
+	// __getattribute__ that warns when deprecated fields are accessed; the
+	// `__eq__` and `__repr__` methods then read the fields without warnings
+	isFirst := true
+	for _, prop := range props {
+		if !prop.IsDeprecated() {
+			continue
+		}
+		if isFirst {
+			b.Writeln(pyInd1 + "def __getattribute__(self, name: str) -> Any:")
+			b.Writeln(pyInd2 + "if name == '" + prop.PyName() + "':")
+			isFirst = false
+		} else {
+			b.Writeln(pyInd2 + "elif name == '" + prop.PyName() + "':")
 		}
+		note := prop.DeprecationNote(class.Name + "." + prop.PyName())
+		b.Writeln(pyInd3 + "warnings.warn(" + pyStringOf(note) +
+			", DeprecationWarning, stacklevel=2)")
+	}
+	if !isFirst {
+		b.Writeln(pyInd2 + "return super().__getattribute__(name)")
+		b.Writeln()
+		b.Writeln(pyInd1 + "def __eq__(self, other: object) -> bool:")
+		b.Writeln(pyInd2 + "if other.__class__ is not self.__class__:")
+		b.Writeln(pyInd3 + "return NotImplemented")
+		b.Writeln(pyInd2 + "return _values_of(self) == _values_of(other)")
+		b.Writeln()
+		b.Writeln(pyInd1 + "def __repr__(self) -> str:")
+		b.Writeln(pyInd2 + "values = ', '.join(f'{k}={v!r}' for k, v in _values_of(self).items())")
+		b.Writeln(pyInd2 + "return f'{self.__class__.__qualname__}({values})'")
 		b.Writeln()
 	}
 
 	// to_dict
 	b.Writeln(pyInd1 + "def to_dict(self) -> Dict[str, Any]:")
 	b.Writeln(pyInd2 + "d: Dict[str, Any] = {}")
-	if model.IsRoot(class) {
+	if model.IsRoot(class) || model.IsUnionMember(class) {
 		b.Writeln(pyInd2 + "d['@type'] = '" + class.Name + "'")
 	}
-	if class.Name == "Ref" {
-		b.Writeln(pyInd2 + "d['@type'] = self.model_type")
+	if typeField != "" {
+		b.Writeln(pyInd2 + "d['@type'] = self." + typeField)
 	}
 	for _, prop := range props {
 		if prop.Name == "@type" {
 			continue
 		}
 		selfProp := "self." + prop.PyName()
+		if prop.IsDeprecated() {
+			// do not trigger the deprecation warning when serializing the object
+			selfProp = "self.__dict__.get('" + prop.PyName() + "')"
+		}
 		dictProp := pyInd3 + "d['" + prop.Name + "']"
 		propType := prop.PropType()
-		b.Writeln("        if " + selfProp + ":")
-		if propType.IsPrimitive() ||
-			(propType.IsList() && propType.UnpackList().IsPrimitive()) ||
-			propType == "GeoJSON" {
-			b.Writeln(dictProp + " = " + selfProp)
-		} else if propType.IsEnumOf(model) {
-			b.Writeln(dictProp + " = " + selfProp + ".value")
-		} else if propType.IsList() {
-			b.Writeln(dictProp + " = [e.to_dict() for e in " + selfProp + "]")
+		if propType.IsList() && model.Manifest.Generators.Python.OmitEmptyLists {
+			b.Writeln(pyInd2 + "if " + selfProp + ":")
 		} else {
+			b.Writeln(pyInd2 + "if " + selfProp + " is not None:")
+		}
+		if propType.IsUnion() {
 			b.Writeln(dictProp + " = " + selfProp + ".to_dict()")
+		} else {
+			b.Writeln(dictProp + " = " + model.pyToDict(propType, selfProp))
 		}
 	}
 	b.Writeln(pyInd2 + "return d")
@@ -158,37 +485,78 @@ func (model *YamlModel) ToPyClass(class *YamlClass) string {
 	}
 
 	// to_ref
-	if model.IsRoot(class) || class.Name == "Unit" {
-		b.Writeln(pyInd1 + "def to_ref(self) -> 'Ref':")
-		b.Writeln(pyInd2 + "ref = Ref(id=self.id, name=self.name)")
-		b.Writeln(pyInd2 + "ref.category = self.category")
-		b.Writeln(pyInd2 + "ref.model_type = '" + class.Name + "'")
+	if model.HasToRef(class) {
+		ref := model.RefClass()
+		refType := ref.Name + "[" + class.Name + "]"
+		b.Writeln(pyInd1 + "def to_ref(self) -> '" + refType + "':")
+		b.Writeln(pyInd2 + "ref: " + refType + " = " + ref.Name +
+			"(id=self.id, name=self.name)")
+		for _, prop := range props {
+			if prop.Name == "category" {
+				b.Writeln(pyInd2 + "ref.category = self.category")
+			}
+		}
+		if field := ref.Annotations.String("x-python-type-field"); field != "" {
+			b.Writeln(pyInd2 + "ref." + field + " = '" + class.Name + "'")
+		}
 		b.Writeln(pyInd2 + "return ref")
 		b.Writeln()
 	}
 
 	// from_dict
 	b.Writeln(pyInd1 + "@staticmethod")
-	b.Writeln(pyInd1 + "def from_dict(d: Dict[str, Any]) -> '" + class.Name + "':")
 	instance := strings.ToLower(toSnakeCase(class.Name))
-	b.Writeln(pyInd2 + instance + " = " + class.Name + "()")
-	if class.Name == "Ref" {
-		b.Writeln(pyInd2 + instance + ".model_type = d.get('@type', '')")
+	b.Writeln(pyInd1 + pyFromDictOf(class) + ":")
+	if len(required) == 0 {
+		if isRef {
+			b.Writeln(pyInd2 + instance + ": " + class.Name + "[Any] = " +
+				class.Name + "()")
+		} else {
+			b.Writeln(pyInd2 + instance + " = " + class.Name + "()")
+		}
+	} else {
+		// the required fields are set below; missing values are reported by
+		// the `validate` method
+		args := make([]string, 0, len(required))
+		for _, prop := range required {
+			args = append(args, prop.PyName()+"=None")
+		}
+		b.Writeln(pyInd2 + instance + " = " + class.Name + "(" +
+			strings.Join(args, ", ") + ")  # type: ignore")
+	}
+	if typeField != "" {
+		b.Writeln(pyInd2 + instance + "." + typeField + " = d.get('@type', '')")
+		if isRef {
+			field := instance + "." + typeField
+			b.Writeln(pyInd2 + "if model_types and " + field + " and " +
+				field + " not in model_types:")
+			b.Writeln(pyInd3 + "raise ValueError(f'invalid @type of reference: {" +
+				field + "}, expected: {\", \".join(model_types)}')")
+		}
 	}
 	for _, prop := range props {
-		b.Writeln("        if v := d.get('" + prop.Name + "'):")
+		if prop.Name == "@type" {
+			continue
+		}
+		b.Writeln("        if (v := d.get('" + prop.Name + "')) is not None:")
 		propType := prop.PropType()
 		modelProp := "            " + instance + "." + prop.PyName()
-		if propType.IsPrimitive() ||
-			propType.IsEnumOf(model) ||
-			(propType.IsList() && propType.UnpackList().IsPrimitive()) ||
-			propType == "GeoJSON" {
-			b.Writeln(modelProp + " = v")
-		} else if propType.IsList() {
-			u := propType.UnpackList()
-			b.Writeln(modelProp + " = [" + string(u) + ".from_dict(e) for e in v]")
+		if propType.IsUnion() {
+			// the alternative is selected by the `@type` of the value
+			for i, alt := range propType.UnpackUnion() {
+				cond := "if"
+				if i > 0 {
+					cond = "elif"
+				}
+				b.Writeln(pyInd3 + cond + " v.get('@type') == '" + string(alt) + "':")
+				b.Writeln(pyInd1 + modelProp + " = " + alt.ToPython(model) +
+					".from_dict(v)")
+			}
+			b.Writeln(pyInd3 + "else:")
+			b.Writeln(pyInd3 + pyInd1 + "raise ValueError('invalid @type of " +
+				prop.Name + ": ' + str(v.get('@type')))")
 		} else {
-			b.Writeln(modelProp + " = " + string(propType) + ".from_dict(v)")
+			b.Writeln(modelProp + " = " + model.pyFromDict(propType, "v"))
 		}
 	}
 	b.Writeln("        return " + instance)
@@ -203,120 +571,543 @@ func (model *YamlModel) ToPyClass(class *YamlClass) string {
 		b.Writeln()
 	}
 
+	// validate
+	b.Writeln(pyInd1 + "def validate(self) -> List[str]:")
+	b.Writeln(pyInd2 + "errors: List[str] = []")
+	b.buff.WriteString(model.pyTypeChecks(class, props))
+	b.buff.WriteString(model.pyConstraintChecks(props))
+	b.Writeln(pyInd2 + "return errors")
+	b.Writeln()
+
 	return b.String()
 }
 
-func (w *pyWriter) writeln(args ...string) {
-	w.write(args...)
-	w.buff.WriteRune('\n')
+// Returns the header of the class definition of the given class; references
+// are generic with the target type as parameter.
+func pyClassHeaderOf(class *YamlClass) string {
+	if class.IsRef() {
+		return "class " + class.Name + "(Generic[_T]):"
+	}
+	return "class " + class.Name + ":"
 }
 
-func (w *pyWriter) write(args ...string) {
-	for i, arg := range args {
-		if i > 0 {
-			w.buff.WriteRune(' ')
-		}
-		w.buff.WriteString(arg)
+// Returns the signature of the `from_dict` method of the given class. For
+// references, the valid target types can be passed to `from_dict`.
+func pyFromDictOf(class *YamlClass) string {
+	if class.IsRef() {
+		return "def from_dict(d: Dict[str, Any], *model_types: str) -> '" +
+			class.Name + "[Any]'"
 	}
+	return "def from_dict(d: Dict[str, Any]) -> '" + class.Name + "'"
 }
 
-func topoSortClasses(model *YamlModel) []*YamlClass {
+// pyField is a field of a generated Python class with its type annotation
+// and initializer. Required fields without default value have no initializer;
+// these are keyword-only fields. Without the `requiredFields` option, all
+// fields are optional.
+type pyField struct {
+	prop   *YamlProp
+	pyType string
+	init   string
+}
 
-	// check if there is a link between a class A and another class B where B is
-	// dependent from A. B is dependent from A if it has a property of type A.
-	isLinked := func(class, dependent *YamlClass) bool {
-		if class == dependent {
-			return false
+// Returns the fields of the Python class of the given class. Root entities get
+// a new UUID as ID by default.
+func (model *YamlModel) pyFieldsOf(class *YamlClass) []*pyField {
+	var fields []*pyField
+	for _, prop := range model.AllPropsOf(class) {
+		if prop.Name == "@type" {
+			continue
 		}
-		for _, prop := range dependent.Props {
-			propType := YamlPropType(prop.Type)
-			if propType.IsList() {
-				propType = propType.UnpackList()
-			}
-			if propType.ToPython() == class.Name {
-				return true
-			}
+		f := &pyField{
+			prop:   prop,
+			pyType: prop.PropType().ToPython(model),
+			init:   model.pyDefaultOf(prop),
 		}
+		required := model.Manifest.Generators.Python.RequiredFields &&
+			model.pyIsRequired(class, prop)
+		if !required {
+			f.pyType = "Optional[" + f.pyType + "]"
+		}
+		if model.IsRoot(class) && prop.PyName() == "id" {
+			f.init = "field(default_factory=lambda: str(uuid.uuid4()))"
+		} else if f.init == "None" && required {
+			f.init = ""
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// Returns true if the given property is a required field of the Python class.
+// The last change of root entities is not required, as it is initialized in
+// `__post_init__`.
+func (model *YamlModel) pyIsRequired(class *YamlClass, prop *YamlProp) bool {
+	if !prop.Required {
 		return false
 	}
+	return !model.IsRoot(class) || prop.PyName() != "last_change"
+}
 
-	// collect the dependencies
-	dependencyCount := make(map[string]int)
-	dependents := make(map[string][]string)
-	model.EachClass(func(class *YamlClass) {
-		if _, ok := dependencyCount[class.Name]; !ok {
-			dependencyCount[class.Name] = 0
+// Returns the docstring of the given documentation, wrapped at 80 characters
+// with formatComment, or an empty string if there is no documentation.
+func pyDocstringOf(doc, indent string) string {
+	doc = strings.ReplaceAll(doc, "\\", "\\\\")
+	doc = strings.ReplaceAll(doc, `"""`, `\"\"\"`)
+	lines := formatCommentLines(doc, indent)
+	if len(lines) == 0 {
+		return ""
+	}
+	last := lines[len(lines)-1]
+	if len(lines) == 1 && len(indent)+len(last)+6 < 80 &&
+		!strings.HasSuffix(last, `"`) {
+		return indent + `"""` + last + `"""` + "\n"
+	}
+	var b strings.Builder
+	for i, line := range lines {
+		if i == 0 {
+			b.WriteString(indent + `"""` + line + "\n")
+		} else {
+			b.WriteString(indent + line + "\n")
+		}
+	}
+	b.WriteString(indent + `"""` + "\n")
+	return b.String()
+}
+
+// Generates the checks of the `validate` method for missing required values
+// and values of wrong types. Values that are objects of the schema are
+// validated recursively; their errors are prefixed with the path of the value.
+func (model *YamlModel) pyTypeChecks(class *YamlClass, props []*YamlProp) string {
+	b := NewBuffer()
+	for _, prop := range props {
+		if prop.Name == "@type" {
+			continue
+		}
+		field := "self." + prop.PyName()
+		if prop.IsDeprecated() {
+			field = "self.__dict__.get('" + prop.PyName() + "')"
+		}
+		path := pyStringOf(prop.PyName())
+		if model.pyIsRequired(class, prop) {
+			b.Writeln(pyInd2 + "if " + field + " is None:")
+			b.Writeln(pyInd3 + "errors.append(" +
+				pyStringOf(prop.PyName()+": value is required") + ")")
+			b.Writeln(pyInd2 + "else:")
+		} else {
+			b.Writeln(pyInd2 + "if " + field + " is not None:")
 		}
-		model.EachClass(func(dependent *YamlClass) {
-			if isLinked(class, dependent) {
-				c := class.Name
-				d := dependent.Name
-				dependencyCount[d] += 1
-				dependents[c] = append(dependents[c], d)
-			}
-		})
-	})
 
-	// make sure that every RootEntity is dependent from 'Ref' as we generate a
-	// to_ref method where the Ref type should be known
-	refDeps, ok := dependents["Ref"]
-	if !ok {
-		refDeps = make([]string, 0)
+		// the elements of lists and maps are only checked if the value is a
+		// list or map
+		propType := prop.PropType()
+		switch {
+		case propType.IsList():
+			b.Writeln(pyInd3 + "if " + model.pyCheckOf(propType, field, path) + ":")
+			b.Writeln(pyInd3 + pyInd1 + "for i, e in enumerate(" + field + "):")
+			b.Writeln(pyInd3 + pyInd2 + model.pyCheckOf(propType.UnpackList(),
+				"e", "f'"+prop.PyName()+"[{i}]'"))
+		case propType.IsMap():
+			_, valueType := propType.UnpackMap()
+			b.Writeln(pyInd3 + "if " + model.pyCheckOf(propType, field, path) + ":")
+			b.Writeln(pyInd3 + pyInd1 + "for k, v in " + field + ".items():")
+			b.Writeln(pyInd3 + pyInd2 + model.pyCheckOf(valueType,
+				"v", "f'"+prop.PyName()+"[{k!r}]'"))
+		default:
+			b.Writeln(pyInd3 + model.pyCheckOf(propType, field, path))
+		}
 	}
-	model.EachClass(func(class *YamlClass) {
-		if !model.IsRoot(class) && class.Name != "Unit" {
-			return
+	return b.String()
+}
+
+// Returns the call of `_check_value` for the given value of the given type.
+func (model *YamlModel) pyCheckOf(t YamlPropType, value, path string) string {
+	var types []string
+	if t.IsUnion() {
+		for _, alt := range t.UnpackUnion() {
+			types = append(types, model.pyInstanceTypeOf(alt))
 		}
-		contains := false
-		for _, dep := range refDeps {
-			if class.Name == dep {
-				contains = true
-				break
-			}
+	} else {
+		types = append(types, model.pyInstanceTypeOf(t))
+	}
+	var typeList string
+	if len(types) == 1 {
+		typeList = types[0]
+	} else {
+		typeList = "(" + strings.Join(types, ", ") + ")"
+	}
+	return "_check_value(" + value + ", " + typeList + ", " +
+		pyStringOf(string(t)) + ", " + path + ", errors)"
+}
+
+// Returns the Python type for `isinstance` checks of the given type. Integers
+// are also valid values of floating point numbers.
+func (model *YamlModel) pyInstanceTypeOf(t YamlPropType) string {
+	switch pyType := t.ToPython(model); {
+	case t.IsList():
+		return "list"
+	case t.IsMap():
+		return "dict"
+	case t.IsRef():
+		return model.RefClass().Name
+	case pyType == "float":
+		return "(int, float)"
+	default:
+		return pyType
+	}
+}
+
+// Generates the checks of the property constraints for the `validate` method
+// of a class. Returns an empty string if there are no constraints to check.
+func (model *YamlModel) pyConstraintChecks(props []*YamlProp) string {
+	b := NewBuffer()
+	for _, prop := range props {
+		c := model.ConstraintsOf(prop)
+		if c.IsEmpty() {
+			continue
 		}
-		if !contains {
-			refDeps = append(refDeps, class.Name)
-			dependencyCount[class.Name] += 1
+		field := "self." + prop.PyName()
+		propType := prop.PropType()
+
+		// for lists and maps, the length constraints are checked on the
+		// container and the value constraints on its elements
+		value := field
+		ind := pyInd3
+		var lengthChecks, valueChecks [][2]string
+		if propType.IsList() || propType.IsMap() {
+			lengthChecks = pyLengthChecks(c, field)
+			value = "e"
+			ind = pyInd3 + pyInd1
+			valueChecks = pyValueChecks(c, value, false)
+		} else {
+			valueChecks = pyValueChecks(c, value, true)
+		}
+		if len(lengthChecks) == 0 && len(valueChecks) == 0 {
+			continue
 		}
-	})
-	dependents["Ref"] = refDeps
 
-	// sort dependencies in topological order
-	order := make([]string, 0)
-	for len(dependencyCount) > 0 {
+		b.Writeln(pyInd2 + "if " + field + " is not None:")
+		for _, check := range lengthChecks {
+			b.Writeln(pyInd3 + "if " + check[0] + ":")
+			b.Writeln(pyInd3 + pyInd1 + "errors.append(" +
+				strconv.Quote(prop.PyName()+": "+check[1]) + ")")
+		}
+		if len(valueChecks) == 0 {
+			continue
+		}
+		if propType.IsList() {
+			b.Writeln(pyInd3 + "for e in " + field + ":")
+		} else if propType.IsMap() {
+			b.Writeln(pyInd3 + "for e in " + field + ".values():")
+		}
+		for _, check := range valueChecks {
+			b.Writeln(ind + "if " + check[0] + ":")
+			b.Writeln(ind + pyInd1 + "errors.append(" +
+				strconv.Quote(prop.PyName()+": "+check[1]) + ")")
+		}
+	}
+	return b.String()
+}
 
-		// find next node with no dependencies; if there are multiple options, try
-		// to do this in alphabetical order so that we get a stable sort order
-		node := ""
-		for n, count := range dependencyCount {
-			if count > 0 {
-				continue
-			}
-			if node == "" ||
-				strings.Compare(strings.ToLower(n), strings.ToLower(node)) < 0 {
-				node = n
-			}
+// Returns the conditions and messages of the length constraints that are
+// violated when the respective condition is true.
+func pyLengthChecks(c *YamlConstraints, value string) [][2]string {
+	var checks [][2]string
+	if c.MinLength != nil {
+		n := strconv.Itoa(*c.MinLength)
+		checks = append(checks, [2]string{
+			"len(" + value + ") < " + n, "length must be >= " + n})
+	}
+	if c.MaxLength != nil {
+		n := strconv.Itoa(*c.MaxLength)
+		checks = append(checks, [2]string{
+			"len(" + value + ") > " + n, "length must be <= " + n})
+	}
+	return checks
+}
+
+// Returns the conditions and messages of the value constraints that are
+// violated when the respective condition is true.
+func pyValueChecks(c *YamlConstraints, value string, withLength bool) [][2]string {
+	num := func(f *float64) string {
+		return strconv.FormatFloat(*f, 'g', -1, 64)
+	}
+	var checks [][2]string
+	if c.Min != nil {
+		checks = append(checks, [2]string{
+			value + " < " + num(c.Min), "value must be >= " + num(c.Min)})
+	}
+	if c.ExclusiveMin != nil {
+		checks = append(checks, [2]string{
+			value + " <= " + num(c.ExclusiveMin),
+			"value must be > " + num(c.ExclusiveMin)})
+	}
+	if c.Max != nil {
+		checks = append(checks, [2]string{
+			value + " > " + num(c.Max), "value must be <= " + num(c.Max)})
+	}
+	if c.ExclusiveMax != nil {
+		checks = append(checks, [2]string{
+			value + " >= " + num(c.ExclusiveMax),
+			"value must be < " + num(c.ExclusiveMax)})
+	}
+	if c.Pattern != "" {
+		checks = append(checks, [2]string{
+			"re.search(" + strconv.Quote(c.Pattern) + ", " + value + ") is None",
+			"value does not match pattern " + c.Pattern})
+	}
+	if withLength {
+		checks = append(checks, pyLengthChecks(c, value)...)
+	}
+	return checks
+}
+
+// Returns the default value of the dataclass field of the given property. As
+// lists and dictionaries are mutable, we use factories for them.
+func (model *YamlModel) pyDefaultOf(prop *YamlProp) string {
+	if prop.Default == nil {
+		return "None"
+	}
+	literal := model.pyLiteralOf(prop.PropType(), prop.Default)
+	switch prop.Default.(type) {
+	case []interface{}, map[string]interface{}:
+		return "field(default_factory=lambda: " + literal + ")"
+	default:
+		return literal
+	}
+}
+
+// Returns the Python literal of the given (normalized) default value.
+func (model *YamlModel) pyLiteralOf(t YamlPropType, value interface{}) string {
+	switch v := value.(type) {
+	case bool:
+		if v {
+			return "True"
+		}
+		return "False"
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		s := strconv.FormatFloat(v, 'g', -1, 64)
+		if !strings.ContainsAny(s, ".e") {
+			s += ".0"
+		}
+		return s
+	case string:
+		if t.IsEnumOf(model) {
+			return string(t) + "." + v
+		}
+		return pyStringOf(v)
+	case []interface{}:
+		elemType := t.UnpackList()
+		elems := make([]string, 0, len(v))
+		for _, elem := range v {
+			elems = append(elems, model.pyLiteralOf(elemType, elem))
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	case map[string]interface{}:
+		_, valueType := t.UnpackMap()
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
 		}
+		sort.Strings(keys)
+		entries := make([]string, 0, len(v))
+		for _, key := range keys {
+			entries = append(entries, pyStringOf(key)+": "+
+				model.pyLiteralOf(valueType, v[key]))
+		}
+		return "{" + strings.Join(entries, ", ") + "}"
+	default:
+		return "None"
+	}
+}
+
+// Returns the given string as single-quoted Python string literal.
+func pyStringOf(s string) string {
+	s = strings.ReplaceAll(s, "\\", "\\\\")
+	s = strings.ReplaceAll(s, "'", "\\'")
+	s = strings.ReplaceAll(s, "\n", "\\n")
+	return "'" + s + "'"
+}
 
-		if node == "" {
-			log.Println("ERROR: could not sort classes in topological order")
-			break
+// Returns the Python expression that converts the given value of the given
+// type into its JSON representation, e.g. `{k: v.to_dict() for k, v in x}`.
+func (model *YamlModel) pyToDict(t YamlPropType, value string) string {
+	if t.IsList() {
+		elem := model.pyToDict(t.UnpackList(), "e")
+		if elem == "e" {
+			return value
+		}
+		return "[" + elem + " for e in " + value + "]"
+	}
+	if t.IsMap() {
+		_, valueType := t.UnpackMap()
+		elem := model.pyToDict(valueType, "v")
+		if elem == "v" {
+			return value
 		}
-		delete(dependencyCount, node)
-		order = append(order, node)
+		return "{k: " + elem + " for k, v in " + value + ".items()}"
+	}
+	if t.IsEnumOf(model) {
+		return value + ".value"
+	}
+	switch model.pyDateKindOf(t) {
+	case "date":
+		return value + ".isoformat()"
+	case "dateTime":
+		return "_format_datetime(" + value + ")"
+	}
+	if t.IsPrimitiveOf(model) {
+		return value
+	}
+	return value + ".to_dict()"
+}
 
-		// remove the handled dependency from its dependents
-		for _, dependent := range dependents[node] {
-			dependencyCount[dependent] -= 1
+// Returns the Python expression that converts the given JSON value into an
+// instance of the given type; the inverse of pyToDict.
+func (model *YamlModel) pyFromDict(t YamlPropType, value string) string {
+	if t.IsList() {
+		elem := model.pyFromDict(t.UnpackList(), "e")
+		if elem == "e" {
+			return value
+		}
+		return "[" + elem + " for e in " + value + "]"
+	}
+	if t.IsMap() {
+		_, valueType := t.UnpackMap()
+		elem := model.pyFromDict(valueType, "v")
+		if elem == "v" {
+			return value
 		}
+		return "{k: " + elem + " for k, v in " + value + ".items()}"
+	}
+	if t.IsEnumOf(model) {
+		return string(t) + "(" + value + ")"
+	}
+	switch model.pyDateKindOf(t) {
+	case "date":
+		return "datetime.date.fromisoformat(" + value + ")"
+	case "dateTime":
+		return "_parse_datetime(" + value + ")"
 	}
+	if t.IsPrimitiveOf(model) {
+		return value
+	}
+	if t.IsRef() {
+		targets := []string{value}
+		for _, name := range model.pyRefTargetsOf(t.UnpackRef()) {
+			targets = append(targets, pyStringOf(name))
+		}
+		return model.RefClass().Name + ".from_dict(" + strings.Join(targets, ", ") + ")"
+	}
+	return t.ToPython(model) + ".from_dict(" + value + ")"
+}
 
-	sorted := make([]*YamlClass, 0, len(order))
-	for _, name := range order {
-		next := model.TypeMap[name]
-		if next != nil && next.IsClass() {
-			sorted = append(sorted, next.Class)
+// Returns the names of the types that are valid targets of a reference with
+// the given target type: the type itself, or the concrete sub-classes if it
+// is an abstract class. It returns nil when the target type is not in the
+// model, e.g. when it was removed by a profile; such a `Ref[Any]` then accepts
+// references of any type.
+func (model *YamlModel) pyRefTargetsOf(target YamlPropType) []string {
+	t := model.TypeMap[string(target)]
+	if t == nil || !t.IsClass() {
+		return nil
+	}
+	var names []string
+	var collect func(class *YamlClass)
+	collect = func(class *YamlClass) {
+		if !model.IsAbstract(class) {
+			names = append(names, class.Name)
+		}
+		for _, sub := range model.Index().SubClassesOf(class) {
+			collect(sub)
 		}
 	}
-	return sorted
+	collect(t.Class)
+	return names
+}
+
+// Returns `date` or `dateTime` if the given type is one of these primitives,
+// or derived from them, and dates are parsed into `datetime` objects.
+// Otherwise, it returns an empty string.
+func (model *YamlModel) pyDateKindOf(t YamlPropType) string {
+	if !model.Manifest.Generators.Python.ParseDates {
+		return ""
+	}
+	for p := model.Primitives[string(t)]; p != nil; p = model.Primitives[p.Base] {
+		if p.Name == "date" || p.Name == "dateTime" {
+			return p.Name
+		}
+	}
+	return ""
+}
+
+func (w *pyWriter) writeln(args ...string) {
+	w.write(args...)
+	w.buff.WriteRune('\n')
+}
+
+func (w *pyWriter) write(args ...string) {
+	for i, arg := range args {
+		if i > 0 {
+			w.buff.WriteRune(' ')
+		}
+		w.buff.WriteString(arg)
+	}
+}
+
+// Returns the concrete classes of the Python module in the order in which
+// they are written: first the value types and then the root entities, each
+// sorted by name. As annotations are not evaluated, the order does not matter
+// for the type hints. Returns an error if the name of a type clashes with a
+// name that is defined or imported in the generated module, or if the name of
+// a property is not a valid Python name.
+func pyClassesOf(model *YamlModel) ([]*YamlClass, error) {
+	var values, roots []*YamlClass
+	for _, t := range model.Types {
+		if t.IsClass() {
+			for _, prop := range t.Class.Props {
+				if err := prop.checkPyName(); err != nil {
+					return nil, fmt.Errorf("property %s of class %s: %w",
+						prop.Name, t.Name(), err)
+				}
+			}
+		}
+		if t.IsClass() && model.IsAbstract(t.Class) {
+			continue
+		}
+		name := t.Name()
+		if pyModuleNames[name] || pyKeywords[name] || strings.HasPrefix(name, "_") {
+			return nil, fmt.Errorf(
+				"the name of type %s clashes with a name in the Python module", name)
+		}
+		if !t.IsClass() {
+			continue
+		}
+		if model.IsRoot(t.Class) {
+			roots = append(roots, t.Class)
+		} else {
+			values = append(values, t.Class)
+		}
+	}
+	byName := func(classes []*YamlClass) {
+		sort.SliceStable(classes, func(i, j int) bool {
+			return classes[i].Name < classes[j].Name
+		})
+	}
+	byName(values)
+	byName(roots)
+	return append(values, roots...), nil
+}
+
+// The names that are imported or defined in the generated Python modules,
+// besides the types of the schema.
+var pyModuleNames = map[string]bool{
+	"Annotated": true, "Any": true, "BaseModel": true, "ConfigDict": true,
+	"Dict": true, "Enum": true, "EnumMeta": true, "Field": true, "Generic": true,
+	"List": true, "Literal": true, "Optional": true, "RootEntity": true,
+	"SCHEMA_VERSION": true, "Type": true, "TypeAdapter": true, "TypeVar": true,
+	"Union": true, "dataclass": true, "datetime": true, "field": true,
+	"json": true, "re": true, "uuid": true, "warnings": true,
 }
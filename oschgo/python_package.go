@@ -0,0 +1,234 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// PyHelpersModule is the name of the optional, hand-written module of a package
+// with helper functions. The generator does not write this module, so that it
+// is kept when the package is generated again, and re-exports its functions.
+const PyHelpersModule = "helpers"
+
+// Matches the public functions and classes of a Python module.
+var pyPublicDef = regexp.MustCompile(`(?m)^(?:def|class) ([A-Za-z][A-Za-z0-9_]*)`)
+
+// Writes the Python package into the given folder: the package folder with
+// the modules, their typing stubs, and the `py.typed` marker, and the
+// `pyproject.toml` file of the project.
+func (w *pyWriter) writePackage(target string) {
+	if target == "" {
+		fmt.Println("ERROR: no output folder for the Python package given")
+		return
+	}
+	pkg := w.packageName()
+	dir := filepath.Join(target, pkg)
+	mkdir(dir)
+
+	module := w.buff.String()
+	writeFile(filepath.Join(dir, "schema.py"), module)
+	writeFile(filepath.Join(dir, "schema.pyi"), w.schemaStub())
+	w.writeModules(dir, "schema", true)
+	// the init module only re-exports the types and helpers, so it is its own
+	// stub
+	init := w.initModule(pyHelpersOf(filepath.Join(dir, PyHelpersModule+".py")))
+	writeFile(filepath.Join(dir, "__init__.py"), init)
+	writeFile(filepath.Join(dir, "__init__.pyi"), init)
+	writeFile(filepath.Join(dir, "py.typed"), "")
+	writeFile(filepath.Join(target, "pyproject.toml"), w.pyproject(pkg))
+}
+
+// Returns the name of the generated package as defined in the manifest.
+func (w *pyWriter) packageName() string {
+	if pkg := w.model.Manifest.Generators.Python.Package; pkg != "" {
+		return pkg
+	}
+	return "olca_schema"
+}
+
+// Returns the names of the public functions and classes of the helpers module
+// in the given file, or nil if there is no such file.
+func pyHelpersOf(file string) []string {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil
+	}
+	var names []string
+	for _, match := range pyPublicDef.FindAllStringSubmatch(string(data), -1) {
+		names = append(names, match[1])
+	}
+	return names
+}
+
+// Generates the `__init__.py` module of the package that re-exports the types
+// of the schema module and the given functions of the helpers module.
+func (w *pyWriter) initModule(helpers []string) string {
+	names := []string{"SCHEMA_VERSION"}
+	for _, t := range w.model.Types {
+		if t.IsClass() && w.model.IsAbstract(t.Class) {
+			continue
+		}
+		names = append(names, t.Name())
+	}
+	if w.hasRoots() {
+		names = append(names, "RootEntity")
+	}
+
+	b := NewBuffer()
+	b.Writeln("# DO NOT CHANGE THIS CODE AS THIS IS GENERATED AUTOMATICALLY")
+	b.Writeln()
+	b.Writeln("from .schema import (")
+	for _, name := range names {
+		b.Writeln(pyInd1 + name + ",")
+	}
+	b.Writeln(")")
+	// export the modules too, e.g. for `olca_schema.schema.SCHEMA_VERSION`
+	b.Writeln("from . import schema")
+	names = append(names, "schema")
+	if w.hasRoots() {
+		b.Writeln("from . import zipio")
+		names = append(names, "zipio")
+	}
+	if len(helpers) > 0 {
+		b.Writeln("from ." + PyHelpersModule + " import (")
+		for _, name := range helpers {
+			b.Writeln(pyInd1 + name + ",")
+		}
+		b.Writeln(")")
+		names = append(names, helpers...)
+	}
+	b.Writeln()
+	b.Writeln("__all__ = [")
+	for _, name := range names {
+		b.Writeln(pyInd1 + "'" + name + "',")
+	}
+	b.Writeln("]")
+	return b.String()
+}
+
+// Generates the `pyproject.toml` file of the package with the version of the
+// schema.
+func (w *pyWriter) pyproject(pkg string) string {
+	manifest := w.model.Manifest
+	version := manifest.Version
+	if version == "" {
+		version = "0.0.0"
+	}
+	b := NewBuffer()
+	b.Writeln("[build-system]")
+	b.Writeln(`requires = ["setuptools>=61"]`)
+	b.Writeln(`build-backend = "setuptools.build_meta"`)
+	b.Writeln()
+	b.Writeln("[project]")
+	b.Writeln("name = " + tomlStringOf(pkg))
+	b.Writeln("version = " + tomlStringOf(version))
+	if manifest.Name != "" {
+		b.Writeln("description = " + tomlStringOf(
+			"A package for reading and writing data sets in the "+manifest.Name+"."))
+	}
+	if manifest.Generators.Python.RequiredFields {
+		// keyword-only fields were added in Python 3.10
+		b.Writeln(`requires-python = ">=3.10"`)
+	} else {
+		b.Writeln(`requires-python = ">=3.8"`)
+	}
+	if manifest.License != "" {
+		b.Writeln("license = { text = " + tomlStringOf(manifest.License) + " }")
+	}
+	if manifest.BaseUrl != "" {
+		b.Writeln()
+		b.Writeln("[project.urls]")
+		b.Writeln("Homepage = " + tomlStringOf(manifest.BaseUrl))
+	}
+	b.Writeln()
+	b.Writeln("[tool.setuptools]")
+	b.Writeln("packages = [" + tomlStringOf(pkg) + "]")
+	b.Writeln()
+	b.Writeln("[tool.setuptools.package-data]")
+	b.Writeln(pkg + ` = ["py.typed", "*.pyi"]`)
+	return b.String()
+}
+
+func tomlStringOf(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
+
+// Generates the typing stub of the schema module from the model: the
+// enumerations, the data classes with their fields and the signatures of
+// their methods, and the `RootEntity` union.
+func (w *pyWriter) schemaStub() string {
+	model := w.model
+	b := NewBuffer()
+	b.Writeln("# DO NOT CHANGE THIS CODE AS THIS IS GENERATED AUTOMATICALLY")
+	b.Writeln()
+	b.Writeln("# This module contains the typing stubs of the schema module.")
+	b.Writeln()
+	b.Writeln("import datetime")
+	b.Writeln()
+	b.Writeln("from enum import Enum")
+	b.Writeln("from dataclasses import dataclass, field")
+	b.Writeln("from typing import Any, Dict, Generic, List, Optional, TypeVar, Union")
+	b.Writeln()
+	b.Writeln("SCHEMA_VERSION: str")
+	if model.RefClass() != nil {
+		b.Writeln()
+		b.Writeln("_T = TypeVar('_T')")
+	}
+
+	model.EachEnum(func(enum *YamlEnum) {
+		b.Writeln()
+		b.Writeln("class " + enum.Name + "(Enum):")
+		for _, item := range enum.Items {
+			b.Writeln(pyInd1 + item.Name + " = '" + item.Name + "'")
+		}
+	})
+
+	for _, class := range w.classes {
+		b.Writeln()
+		b.Writeln("@dataclass")
+		b.Writeln(pyClassHeaderOf(class))
+		for _, f := range model.pyFieldsOf(class) {
+			init := "..."
+			if f.init == "" {
+				init = "field(kw_only=True)"
+			}
+			b.Writeln(pyInd1 + f.prop.PyName() + ": " + f.pyType + " = " + init)
+		}
+		if typeField := class.Annotations.String("x-python-type-field"); typeField != "" {
+			b.Writeln(pyInd1 + typeField + ": str = ...")
+		}
+		isRoot := model.IsRoot(class)
+		b.Writeln(pyInd1 + "def to_dict(self) -> Dict[str, Any]: ...")
+		if isRoot {
+			b.Writeln(pyInd1 + "def to_json(self) -> str: ...")
+		}
+		if model.HasToRef(class) {
+			b.Writeln(pyInd1 + "def to_ref(self) -> '" + model.RefClass().Name +
+				"[" + class.Name + "]': ...")
+		}
+		b.Writeln(pyInd1 + "@staticmethod")
+		b.Writeln(pyInd1 + pyFromDictOf(class) + ": ...")
+		if isRoot {
+			b.Writeln(pyInd1 + "@staticmethod")
+			b.Writeln(pyInd1 + "def from_json(data: Union[str, bytes]) -> '" +
+				class.Name + "': ...")
+		}
+		b.Writeln(pyInd1 + "def validate(self) -> List[str]: ...")
+	}
+
+	if w.hasRoots() {
+		b.Writeln()
+		b.Writeln("RootEntity = Union[")
+		model.EachClass(func(class *YamlClass) {
+			if model.IsRoot(class) {
+				b.Writeln(pyInd1 + class.Name + ",")
+			}
+		})
+		b.Writeln("]")
+	}
+	return b.String()
+}
@@ -0,0 +1,338 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// A small schema with the structures of the openLCA schema: root entities,
+// generic references, a value type with `to_ref`, enumerations, lists, maps,
+// unions, and deprecated properties.
+var pyTestFiles = map[string]string{
+	ManifestFile: `name: Test Schema
+version: 1.0.0
+generators:
+  python:
+    requiredFields: true
+`,
+	"Entity.yaml": `class:
+  name: Entity
+  x-proto-skip: true
+  properties:
+  - name: '@type'
+    type: string
+    index: 1
+    x-python-name: schema_type
+    x-proto-name: type
+`,
+	"RefEntity.yaml": `class:
+  name: RefEntity
+  superClass: Entity
+  properties:
+  - name: '@id'
+    type: string
+    index: 2
+    required: true
+    x-python-name: id
+    x-proto-name: id
+  - name: name
+    type: string
+    index: 3
+    required: true
+    doc: The name of the entity.
+`,
+	"RootEntity.yaml": `class:
+  name: RootEntity
+  superClass: RefEntity
+  x-proto-skip: true
+  properties:
+  - name: category
+    type: string
+    index: 4
+  - name: lastChange
+    type: dateTime
+    index: 5
+  - name: tags
+    type: List[string]
+    index: 6
+`,
+	"Ref.yaml": `class:
+  name: Ref
+  superClass: RefEntity
+  x-ref: true
+  x-python-type-field: model_type
+  properties:
+  - name: category
+    type: string
+    index: 4
+  - name: flowType
+    type: FlowType
+    index: 5
+`,
+	"FlowType.yaml": `enum:
+  name: FlowType
+  doc: The type of a flow.
+  items:
+  - name: ELEMENTARY_FLOW
+    index: 1
+  - name: PRODUCT_FLOW
+    index: 2
+`,
+	"Flow.yaml": `class:
+  name: Flow
+  superClass: RootEntity
+  doc: A flow is an input or output of a process.
+  properties:
+  - name: flowType
+    type: FlowType
+    index: 7
+  - name: formula
+    type: string
+    index: 8
+    deprecated: "1.1"
+  - name: properties
+    type: Map[string, double]
+    index: 9
+`,
+	"Unit.yaml": `class:
+  name: Unit
+  superClass: RefEntity
+  x-python-to-ref: true
+  properties:
+  - name: conversionFactor
+    type: double
+    index: 4
+    required: true
+  - name: isRefUnit
+    type: boolean
+    index: 5
+`,
+	"UnitGroup.yaml": `class:
+  name: UnitGroup
+  superClass: RootEntity
+  properties:
+  - name: refFlow
+    type: Ref[Flow]
+    index: 7
+  - name: units
+    type: List[Unit]
+    index: 8
+  - name: source
+    type: Union[Flow, Unit]
+    index: 9
+`,
+}
+
+// Generates the Python package of the test schema into a temporary folder
+// and returns the folder.
+func writePyTestPackage(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	writePyTestPackageTo(t, dir)
+	return dir
+}
+
+// Generates the Python package of the test schema into the given folder.
+func writePyTestPackageTo(t *testing.T, dir string) {
+	t.Helper()
+	model, err := readTestModel(t, pyTestFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	classes, err := pyClassesOf(model)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buffer bytes.Buffer
+	w := &pyWriter{buff: &buffer, model: model, classes: classes}
+	w.writeModel()
+	w.writePackage(dir)
+}
+
+func readTestFile(t *testing.T, file string) string {
+	t.Helper()
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestPyPackageStubs(t *testing.T) {
+	dir := writePyTestPackage(t)
+	stub := readTestFile(t, filepath.Join(dir, "olca_schema", "schema.pyi"))
+	for _, line := range []string{
+		"class Ref(Generic[_T]):",
+		"    model_type: str = ...",
+		"    def from_dict(d: Dict[str, Any], *model_types: str) -> 'Ref[Any]': ...",
+		"    id: str = ...",
+		"    name: str = field(kw_only=True)",
+		"    def to_ref(self) -> 'Ref[Flow]': ...",
+		"    def to_ref(self) -> 'Ref[Unit]': ...",
+		"    def from_json(data: Union[str, bytes]) -> 'UnitGroup': ...",
+		"    source: Optional[Union[Flow, Unit]] = ...",
+	} {
+		if !strings.Contains(stub, line+"\n") {
+			t.Error("missing line in schema.pyi:", line)
+		}
+	}
+	zipStub := readTestFile(t, filepath.Join(dir, "olca_schema", "zipio.pyi"))
+	if !strings.Contains(zipStub,
+		"    def read_unit_group(self, uid: str) -> Optional[schema.UnitGroup]: ...\n") {
+		t.Error("missing read method in zipio.pyi")
+	}
+	if strings.Contains(zipStub, "_FOLDERS") {
+		t.Error("private definitions should not be in zipio.pyi")
+	}
+
+	// the generated files have to be valid Python code, if Python is there
+	python, err := exec.LookPath("python3")
+	if err != nil {
+		t.Skip("python3 is not installed")
+	}
+	files, _ := filepath.Glob(filepath.Join(dir, "olca_schema", "*.py*"))
+	for _, file := range files {
+		if strings.HasSuffix(file, ".typed") {
+			continue
+		}
+		cmd := exec.Command(python, "-c",
+			"import ast, sys; ast.parse(open(sys.argv[1]).read())", file)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Errorf("invalid Python code in %s:\n%s", file, out)
+		}
+	}
+}
+
+// The README promises that the generated package passes `mypy --strict`.
+func TestPyPackageMypy(t *testing.T) {
+	mypy, err := exec.LookPath("mypy")
+	if err != nil {
+		t.Skip("mypy is not installed")
+	}
+	dir := writePyTestPackage(t)
+	cmd := exec.Command(mypy, "--strict", "olca_schema")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("mypy --strict failed:\n%s", out)
+	}
+}
+
+// Keyword-only fields for required properties need Python 3.10.
+func TestPyProjectRequiresPython(t *testing.T) {
+	model, err := readTestModel(t, pyTestFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := &pyWriter{model: model}
+	for requiredFields, expected := range map[bool]string{
+		true:  `requires-python = ">=3.10"`,
+		false: `requires-python = ">=3.8"`,
+	} {
+		model.Manifest.Generators.Python.RequiredFields = requiredFields
+		if project := w.pyproject("olca_schema"); !strings.Contains(project, expected+"\n") {
+			t.Errorf("requiredFields = %v: missing %s in:\n%s",
+				requiredFields, expected, project)
+		}
+	}
+}
+
+// The generated package keeps a hand-written helpers module and re-exports its
+// public functions.
+func TestPyPackageHelpers(t *testing.T) {
+	dir := t.TempDir()
+	mkdir(filepath.Join(dir, "olca_schema"))
+	helpers := filepath.Join(dir, "olca_schema", "helpers.py")
+	code := `from .schema import Flow
+
+
+def flow_of(name: str) -> Flow:
+    return Flow(name=name)
+
+
+def _check(flow: Flow) -> bool:
+    return flow.name is not None
+`
+	writeFile(helpers, code)
+	writePyTestPackageTo(t, dir)
+
+	if readTestFile(t, helpers) != code {
+		t.Error("the helpers module should not be changed")
+	}
+	init := readTestFile(t, filepath.Join(dir, "olca_schema", "__init__.py"))
+	for _, line := range []string{
+		"from .helpers import (",
+		"    flow_of,",
+		"    'flow_of',",
+	} {
+		if !strings.Contains(init, line+"\n") {
+			t.Error("missing line in __init__.py:", line)
+		}
+	}
+	if strings.Contains(init, "_check") {
+		t.Error("private functions should not be re-exported")
+	}
+
+	python, err := exec.LookPath("python3")
+	if err != nil {
+		t.Skip("python3 is not installed")
+	}
+	cmd := exec.Command(python, "-c",
+		"import olca_schema; assert olca_schema.flow_of('steel').name == 'steel'")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Errorf("the helpers are not available in the package:\n%s", out)
+	}
+}
+
+// The impact categories and methods are stored in the folders of openLCA by
+// default; the `x-zip-folder` annotation overrides this.
+func TestPyZipFolders(t *testing.T) {
+	model, err := readTestModel(t, map[string]string{
+		"RootEntity.yaml": `class:
+  name: RootEntity
+  properties:
+  - name: '@id'
+    type: string
+`,
+		"ImpactCategory.yaml": `class:
+  name: ImpactCategory
+  superClass: RootEntity
+`,
+		"ImpactMethod.yaml": `class:
+  name: ImpactMethod
+  superClass: RootEntity
+`,
+		"UnitGroup.yaml": `class:
+  name: UnitGroup
+  superClass: RootEntity
+  x-zip-folder: units
+`,
+		"Process.yaml": `class:
+  name: Process
+  superClass: RootEntity
+`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	classes, err := pyClassesOf(model)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := &pyWriter{model: model, classes: classes}
+	module := w.zipModuleOf("schema", false)
+	for _, line := range []string{
+		"    schema.ImpactCategory: 'lcia_categories',",
+		"    schema.ImpactMethod: 'lcia_methods',",
+		"    schema.Process: 'processes',",
+		"    schema.UnitGroup: 'units',",
+	} {
+		if !strings.Contains(module, line+"\n") {
+			t.Error("missing zip folder in zipio:", line)
+		}
+	}
+}
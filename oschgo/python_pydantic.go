@@ -0,0 +1,279 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Writes the model as pydantic (v2) classes. The properties have the names of
+// the dataclasses with the JSON names as aliases, and root entities as well as
+// the members of union types have a `schema_type` literal that discriminates
+// the unions on their `@type`.
+func (w *pyWriter) writePydanticModel() {
+	model := w.model
+	w.writeHeader()
+
+	// imports
+	w.writeln("from __future__ import annotations")
+	w.writeln()
+	w.writeln("import datetime")
+	w.writeln("import uuid")
+	w.writeln("import warnings")
+	w.writeln()
+	if len(w.deprecatedItems()) > 0 {
+		w.writeln("from enum import Enum, EnumMeta")
+	} else {
+		w.writeln("from enum import Enum")
+	}
+	w.writeln("from typing import (")
+	w.writeln(pyInd1 + "Annotated, Any, Dict, Generic, List, Literal, Optional, Type, TypeVar,")
+	w.writeln(pyInd1 + "Union)")
+	w.writeln()
+	w.writeln("from pydantic import BaseModel, ConfigDict, Field, TypeAdapter")
+	w.writeln()
+	w.writeln()
+	w.writeln("SCHEMA_VERSION = " + pyStringOf(model.Manifest.Version))
+	w.writeln()
+	if model.RefClass() != nil {
+		// the type parameter of references
+		w.writeln("_T = TypeVar('_T')")
+	}
+	w.writeln("_M = TypeVar('_M', bound='_Model')")
+	w.writeln()
+	w.writeln()
+
+	// the base class of the models
+	w.writeln("class _Model(BaseModel):")
+	w.writeln()
+	w.writeln(pyInd1 + "model_config = ConfigDict(")
+	w.writeln(pyInd2 + "populate_by_name=True, protected_namespaces=())")
+	w.writeln()
+	w.writeln(pyInd1 + "def to_dict(self) -> Dict[str, Any]:")
+	w.writeln(pyInd2 + "return self.model_dump(mode='json', by_alias=True, exclude_none=True)")
+	w.writeln()
+	w.writeln(pyInd1 + "def to_json(self) -> str:")
+	w.writeln(pyInd2 + "return self.model_dump_json(by_alias=True, exclude_none=True, indent=2)")
+	w.writeln()
+	w.writeln(pyInd1 + "@classmethod")
+	w.writeln(pyInd1 + "def from_dict(cls: Type[_M], d: Dict[str, Any]) -> _M:")
+	w.writeln(pyInd2 + "return cls.model_validate(d)")
+	w.writeln()
+	w.writeln(pyInd1 + "@classmethod")
+	w.writeln(pyInd1 + "def from_json(cls: Type[_M], data: Union[str, bytes]) -> _M:")
+	w.writeln(pyInd2 + "return cls.model_validate_json(data)")
+	w.writeln()
+	w.writeln()
+
+	// enums and classes
+	w.writeDeprecatedItems()
+	model.EachEnum(w.writeEnum)
+	classes := w.classes
+	for _, class := range classes {
+		w.writeln(model.ToPydanticClass(class))
+	}
+
+	// the discriminated union of the root entities
+	var roots []string
+	for _, class := range classes {
+		if model.IsRoot(class) {
+			roots = append(roots, class.Name)
+		}
+	}
+	if len(roots) > 0 {
+		w.writeln("RootEntity = Annotated[Union[")
+		for _, root := range roots {
+			w.writeln(pyInd1 + root + ",")
+		}
+		w.writeln("], Field(discriminator='schema_type')]")
+		w.writeln()
+		w.writeln()
+	}
+
+	// resolve the forward references of the classes
+	for _, class := range classes {
+		w.writeln(class.Name + ".model_rebuild()")
+	}
+	if len(roots) == 0 {
+		return
+	}
+	w.writeln()
+	w.writeln()
+	w.writeln("def root_entity_of(d: Dict[str, Any]) -> RootEntity:")
+	w.write(pyDocstringOf("Reads the root entity of the type in the `@type` field "+
+		"of the given dictionary.", pyInd1))
+	w.writeln(pyInd1 + "return TypeAdapter(RootEntity).validate_python(d)")
+	w.writeln()
+	w.writeln()
+	w.writeln("def json_schema() -> Dict[str, Any]:")
+	w.write(pyDocstringOf("Returns the JSON schema of the root entities.", pyInd1))
+	w.writeln(pyInd1 + "return TypeAdapter(RootEntity).json_schema(by_alias=True)")
+}
+
+// ToPydanticClass returns the pydantic model of the given class.
+func (model *YamlModel) ToPydanticClass(class *YamlClass) string {
+	b := NewBuffer()
+	if class.IsRef() {
+		b.Writeln("class " + class.Name + "(_Model, Generic[_T]):")
+	} else {
+		b.Writeln("class " + class.Name + "(_Model):")
+	}
+	b.buff.WriteString(pyDocstringOf(class.Doc, pyInd1))
+	b.Writeln()
+
+	if model.IsRoot(class) || model.IsUnionMember(class) {
+		b.Writeln(pyInd1 + "schema_type: Literal['" + class.Name + "'] = Field('" +
+			class.Name + "', alias='@type')")
+	}
+	if typeField := class.Annotations.String("x-python-type-field"); typeField != "" {
+		b.Writeln(pyInd1 + typeField + ": Optional[str] = Field(None, alias='@type')")
+	}
+	for _, prop := range model.AllPropsOf(class) {
+		if prop.Name == "@type" {
+			continue
+		}
+		b.buff.WriteString(model.pydanticFieldOf(class, prop))
+	}
+	b.Writeln()
+	return b.String()
+}
+
+// Returns the declaration of the field of the given property.
+func (model *YamlModel) pydanticFieldOf(class *YamlClass, prop *YamlProp) string {
+	name := prop.PyName()
+	propType := prop.PropType()
+	pyType := propType.ToPython(model)
+	required := model.pyIsRequired(class, prop)
+
+	var args []string
+	switch {
+	case model.IsRoot(class) && name == "id":
+		args = append(args, "default_factory=lambda: str(uuid.uuid4())")
+	case prop.Default != nil:
+		literal := model.pyLiteralOf(propType, prop.Default)
+		if propType.IsList() || propType.IsMap() {
+			args = append(args, "default_factory=lambda: "+literal)
+		} else {
+			args = append(args, literal)
+		}
+	case !required:
+		args = append(args, "None")
+	}
+	if !required {
+		pyType = "Optional[" + pyType + "]"
+	}
+	hasDefault := len(args) > 0
+	if prop.Name != name {
+		args = append(args, "alias="+pyStringOf(prop.Name))
+	}
+	if propType.IsUnion() && model.pydanticIsDiscriminated(propType) {
+		args = append(args, "discriminator='schema_type'")
+	}
+
+	// the constraints; for lists and maps, the value constraints are checked
+	// on the elements
+	if c := model.ConstraintsOf(prop); !c.IsEmpty() {
+		if propType.IsList() || propType.IsMap() {
+			args = append(args, pydanticLengthArgs(c)...)
+			if elemArgs := pydanticValueArgs(c); len(elemArgs) > 0 {
+				elem := "Annotated[" + pydanticElemType(propType, model) +
+					", Field(" + strings.Join(elemArgs, ", ") + ")]"
+				pyType = strings.Replace(pyType, pydanticElemType(propType, model), elem, 1)
+			}
+		} else {
+			args = append(args, pydanticValueArgs(c)...)
+			args = append(args, pydanticLengthArgs(c)...)
+		}
+	}
+
+	var description []string
+	if doc := strings.Join(strings.Fields(prop.Doc), " "); doc != "" {
+		// wrapped to fit into `description='...',` lines of the field
+		description = formatCommentLines(doc, pyInd2+pyInd3)
+	}
+
+	decl := pyInd1 + name + ": " + pyType
+	switch {
+	case len(args) == 0 && len(description) == 0:
+		return decl + "\n"
+	case len(args) == 1 && hasDefault && len(description) == 0 &&
+		!strings.HasPrefix(args[0], "default_factory"):
+		return decl + " = " + args[0] + "\n"
+	}
+
+	b := NewBuffer()
+	b.Writeln(decl + " = Field(")
+	for _, arg := range args {
+		b.Writeln(pyInd2 + arg + ",")
+	}
+	switch len(description) {
+	case 0:
+	case 1:
+		b.Writeln(pyInd2 + "description=" + pyStringOf(description[0]) + ",")
+	default:
+		b.Writeln(pyInd2 + "description=(")
+		for i, line := range description {
+			if i < len(description)-1 {
+				b.Writeln(pyInd3 + pyStringOf(line+" "))
+			} else {
+				b.Writeln(pyInd3 + pyStringOf(line) + "),")
+			}
+		}
+	}
+	b.Writeln(pyInd1 + ")")
+	return b.String()
+}
+
+// Returns true if all alternatives of the given union type are classes with a
+// `schema_type` literal.
+func (model *YamlModel) pydanticIsDiscriminated(union YamlPropType) bool {
+	for _, alt := range union.UnpackUnion() {
+		t := model.TypeMap[string(alt)]
+		if t == nil || !t.IsClass() || !model.IsUnionMember(t.Class) {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the Python type of the elements of the given list or map type.
+func pydanticElemType(t YamlPropType, model *YamlModel) string {
+	if t.IsList() {
+		return t.UnpackList().ToPython(model)
+	}
+	_, value := t.UnpackMap()
+	return value.ToPython(model)
+}
+
+func pydanticLengthArgs(c *YamlConstraints) []string {
+	var args []string
+	if c.MinLength != nil {
+		args = append(args, "min_length="+strconv.Itoa(*c.MinLength))
+	}
+	if c.MaxLength != nil {
+		args = append(args, "max_length="+strconv.Itoa(*c.MaxLength))
+	}
+	return args
+}
+
+func pydanticValueArgs(c *YamlConstraints) []string {
+	num := func(f *float64) string {
+		return strconv.FormatFloat(*f, 'g', -1, 64)
+	}
+	var args []string
+	if c.Min != nil {
+		args = append(args, "ge="+num(c.Min))
+	}
+	if c.ExclusiveMin != nil {
+		args = append(args, "gt="+num(c.ExclusiveMin))
+	}
+	if c.Max != nil {
+		args = append(args, "le="+num(c.Max))
+	}
+	if c.ExclusiveMax != nil {
+		args = append(args, "lt="+num(c.ExclusiveMax))
+	}
+	if c.Pattern != "" {
+		args = append(args, "pattern="+strconv.Quote(c.Pattern))
+	}
+	return args
+}
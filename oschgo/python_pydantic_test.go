@@ -0,0 +1,147 @@
+package main
+
+import (
+	"bytes"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Generates the pydantic module of the test schema.
+func pydanticModuleOf(t *testing.T) string {
+	t.Helper()
+	model, err := readTestModel(t, pyTestFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	classes, err := pyClassesOf(model)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buffer bytes.Buffer
+	w := &pyWriter{buff: &buffer, model: model, classes: classes}
+	w.writePydanticModel()
+	return buffer.String()
+}
+
+func TestPydanticModel(t *testing.T) {
+	module := pydanticModuleOf(t)
+	for _, line := range []string{
+		"class Flow(_Model):",
+		"    schema_type: Literal['Flow'] = Field('Flow', alias='@type')",
+		"    model_type: Optional[str] = Field(None, alias='@type')",
+		"    id: str = Field(",
+		"        alias='@id',",
+		"    conversion_factor: float = Field(",
+		"        alias='conversionFactor',",
+		"    ref_flow: Optional[Ref[Flow]] = Field(",
+		"], Field(discriminator='schema_type')]",
+		"def root_entity_of(d: Dict[str, Any]) -> RootEntity:",
+		"def json_schema() -> Dict[str, Any]:",
+	} {
+		if !strings.Contains(module, line+"\n") {
+			t.Error("missing line in pydantic module:", line)
+		}
+	}
+}
+
+// The Python tests of the generated pydantic module; they are skipped when
+// pydantic is not installed.
+const pydanticTests = `import json
+import unittest
+
+try:
+    import pydantic
+except ImportError:
+    pydantic = None
+
+if pydantic is not None:
+    import schema
+
+
+@unittest.skipUnless(pydantic, 'pydantic is not installed')
+class PydanticTest(unittest.TestCase):
+
+    def test_round_trip(self):
+        data = {
+            '@type': 'UnitGroup',
+            '@id': 'mass',
+            'name': 'Units of mass',
+            'tags': ['a', 'b'],
+            'refFlow': {'@type': 'Flow', '@id': 'steel', 'name': 'steel'},
+            'units': [{
+                '@type': 'Unit',
+                '@id': 'kg',
+                'name': 'kg',
+                'conversionFactor': 0.0,
+                'isRefUnit': False,
+            }],
+            'source': {
+                '@type': 'Unit',
+                '@id': 'g',
+                'name': 'g',
+                'conversionFactor': 0.001,
+            },
+        }
+        group = schema.UnitGroup.from_dict(data)
+        self.assertEqual('Flow', group.ref_flow.model_type)
+        self.assertIsInstance(group.source, schema.Unit)
+        self.assertEqual(data, group.to_dict())
+        self.assertEqual(data, json.loads(group.to_json()))
+        self.assertEqual(group, schema.UnitGroup.from_json(group.to_json()))
+
+    def test_root_entity_of(self):
+        flow = schema.root_entity_of({
+            '@type': 'Flow',
+            '@id': 'steel',
+            'name': 'steel',
+            'flowType': 'PRODUCT_FLOW',
+            'properties': {'density': 7.85},
+        })
+        self.assertIsInstance(flow, schema.Flow)
+        self.assertEqual(schema.FlowType.PRODUCT_FLOW, flow.flow_type)
+        self.assertEqual({'density': 7.85}, flow.properties)
+        group = schema.root_entity_of(
+            {'@type': 'UnitGroup', '@id': 'mass', 'name': 'mass'})
+        self.assertIsInstance(group, schema.UnitGroup)
+        with self.assertRaises(pydantic.ValidationError):
+            schema.root_entity_of({'@type': 'Unit', '@id': 'kg', 'name': 'kg'})
+        with self.assertRaises(pydantic.ValidationError):
+            schema.root_entity_of({'@type': 'Flow', '@id': 'steel'})
+
+    def test_json_schema(self):
+        s = schema.json_schema()
+        self.assertEqual('@type', s['discriminator']['propertyName'])
+        flow = s['$defs']['Flow']
+        self.assertEqual(
+            'A flow is an input or output of a process.', flow['description'])
+        self.assertIn('@id', flow['properties'])
+        self.assertIn('flowType', flow['properties'])
+        self.assertIn('name', flow['required'])
+        json.dumps(s)
+
+
+if __name__ == '__main__':
+    unittest.main()
+`
+
+// Runs the Python tests of the generated pydantic module if Python and
+// pydantic are installed.
+func TestPydanticRoundTrip(t *testing.T) {
+	python, err := exec.LookPath("python3")
+	if err != nil {
+		t.Skip("python3 is not installed")
+	}
+	if err := exec.Command(python, "-c", "import pydantic").Run(); err != nil {
+		t.Skip("pydantic is not installed")
+	}
+	dir := t.TempDir()
+	writeFile(filepath.Join(dir, "schema.py"), pydanticModuleOf(t))
+	writeFile(filepath.Join(dir, "test_pydantic.py"), pydanticTests)
+	cmd := exec.Command(python, "-m", "unittest", "test_pydantic")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("pydantic tests failed:\n%s", out)
+	}
+}
@@ -0,0 +1,355 @@
+package main
+
+import (
+	"bytes"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Creates a model with the given classes and a root entity hierarchy. The
+// classes are given as `name: super` pairs, and every class has a property
+// of the type of the next class, so that the classes depend on each other in
+// a cycle.
+func pyTestModel(classes ...[2]string) *YamlModel {
+	model := &YamlModel{
+		TypeMap:    make(map[string]*YamlType),
+		Manifest:   defaultManifest(),
+		Primitives: make(YamlPrimitives),
+	}
+	add := func(name, super string, props ...*YamlProp) {
+		t := &YamlType{Class: &YamlClass{Name: name, SuperClass: super, Props: props}}
+		model.Types = append(model.Types, t)
+		model.TypeMap[name] = t
+	}
+	add("Entity", "")
+	add("RootEntity", "Entity")
+	for i, c := range classes {
+		next := classes[(i+1)%len(classes)][0]
+		add(c[0], c[1], &YamlProp{Name: "next", Type: next})
+	}
+	return model
+}
+
+func TestPyClassesOf(t *testing.T) {
+	model := pyTestModel(
+		[2]string{"Zebra", "RootEntity"},
+		[2]string{"Beta", ""},
+		[2]string{"Apple", "RootEntity"},
+		[2]string{"Alpha", ""},
+	)
+	classes, err := pyClassesOf(model)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, class := range classes {
+		names = append(names, class.Name)
+	}
+	order := strings.Join(names, " ")
+	if order != "Alpha Beta Apple Zebra" {
+		t.Error("unexpected class order:", order)
+	}
+}
+
+func TestPyClassesOfNameClash(t *testing.T) {
+	model := pyTestModel([2]string{"List", ""})
+	if _, err := pyClassesOf(model); err == nil {
+		t.Error("expected an error for a class that shadows typing.List")
+	}
+}
+
+func TestPyDeprecatedField(t *testing.T) {
+	model, err := readTestModel(t, map[string]string{
+		"Unit.yaml": `class:
+  name: Unit
+  properties:
+  - name: factor
+    type: double
+    deprecated: "2.1"
+`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := model.ToPyClass(model.TypeMap["Unit"].Class)
+	for _, line := range []string{
+		"    factor: Optional[float] = None",
+		"    def __eq__(self, other: object) -> bool:",
+		"    def __repr__(self) -> str:",
+	} {
+		if !strings.Contains(code, line+"\n") {
+			t.Error("missing line in class with deprecated field:", line)
+		}
+	}
+}
+
+// Deprecated fields are compared and printed without deprecation warnings.
+func TestPyDeprecatedFieldEqRepr(t *testing.T) {
+	python, err := exec.LookPath("python3")
+	if err != nil {
+		t.Skip("python3 is not installed")
+	}
+	model, err := readTestModel(t, map[string]string{
+		"Unit.yaml": `class:
+  name: Unit
+  properties:
+  - name: name
+    type: string
+  - name: factor
+    type: double
+    deprecated: "2.1"
+`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	classes, err := pyClassesOf(model)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buffer bytes.Buffer
+	w := &pyWriter{buff: &buffer, model: model, classes: classes}
+	w.writeModel()
+	dir := t.TempDir()
+	writeFile(filepath.Join(dir, "schema.py"), buffer.String())
+	script := `import warnings
+warnings.simplefilter('error', DeprecationWarning)
+from schema import Unit
+a, b = Unit(name='kg', factor=1.0), Unit(name='kg', factor=2.0)
+assert a != b and a == Unit(name='kg', factor=1.0)
+assert repr(a) == "Unit(factor=1.0, name='kg')", repr(a)
+`
+	cmd := exec.Command(python, "-c", script)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Errorf("deprecated fields in __eq__ and __repr__ failed:\n%s", out)
+	}
+}
+
+func TestPyFalsyValueTests(t *testing.T) {
+	files := map[string]string{
+		"Unit.yaml": `class:
+  name: Unit
+  properties:
+  - name: factor
+    type: double
+  - name: isDefault
+    type: boolean
+  - name: position
+    type: int
+  - name: name
+    type: string
+  - name: synonyms
+    type: List[string]
+`,
+	}
+	for _, omitEmptyLists := range []bool{false, true} {
+		model, err := readTestModel(t, files)
+		if err != nil {
+			t.Fatal(err)
+		}
+		model.Manifest.Generators.Python.OmitEmptyLists = omitEmptyLists
+		classes, err := pyClassesOf(model)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w := &pyWriter{model: model, classes: classes}
+		tests := w.testModuleOf("schema")
+		expected := []string{
+			"'factor': 0.0,",
+			"'is_default': False,",
+			"'position': 0,",
+			"'name': '',",
+		}
+		if omitEmptyLists {
+			expected = append(expected, "self._check_empty_lists(unit, {",
+				"'synonyms': 'synonyms',")
+		} else {
+			expected = append(expected, "'synonyms': [],")
+		}
+		for _, e := range expected {
+			if !strings.Contains(tests, e) {
+				t.Error("omitEmptyLists =", omitEmptyLists, "missing:", e)
+			}
+		}
+	}
+}
+
+// Runs the generated tests of the test schema, with an additional class with
+// date values, for each configuration of the Python generator.
+func TestPyGeneratedTests(t *testing.T) {
+	python, err := exec.LookPath("python3")
+	if err != nil {
+		t.Skip("python3 is not installed")
+	}
+	files := map[string]string{
+		"Period.yaml": `class:
+  name: Period
+  superClass: RootEntity
+  properties:
+  - name: validFrom
+    type: date
+    index: 7
+  - name: validUntil
+    type: date
+    index: 8
+`,
+	}
+	for name, content := range pyTestFiles {
+		files[name] = content
+	}
+	configs := map[string]YamlPythonConfig{
+		"default":        {},
+		"parseDates":     {ParseDates: true},
+		"omitEmptyLists": {OmitEmptyLists: true},
+		"requiredFields": {RequiredFields: true},
+	}
+	for name, config := range configs {
+		model, err := readTestModel(t, files)
+		if err != nil {
+			t.Fatal(err)
+		}
+		model.Manifest.Generators.Python = config
+		classes, err := pyClassesOf(model)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var buffer bytes.Buffer
+		w := &pyWriter{buff: &buffer, model: model, classes: classes}
+		w.writeModel()
+		dir := t.TempDir()
+		writeFile(filepath.Join(dir, "schema.py"), buffer.String())
+		w.writeTests(filepath.Join(dir, "test_schema.py"), "schema")
+		cmd := exec.Command(python, "-m", "unittest", "test_schema")
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Errorf("generated tests failed with %s:\n%s", name, out)
+		}
+	}
+}
+
+func TestPyDefaultNames(t *testing.T) {
+	for name, expected := range map[string]string{
+		"@type":    "schema_type",
+		"@id":      "id",
+		"from":     "from_",
+		"flowType": "flow_type",
+	} {
+		prop := &YamlProp{Name: name}
+		if pyName := prop.PyName(); pyName != expected {
+			t.Errorf("expected %s as Python name of %s, got %s", expected, name, pyName)
+		}
+	}
+}
+
+// The reference class is the class with the `x-ref` annotation, and reference
+// types need such a class.
+func TestPyRefClass(t *testing.T) {
+	files := map[string]string{
+		"Reference.yaml": `class:
+  name: Reference
+  properties:
+  - name: name
+    type: string
+`,
+		"Unit.yaml": `class:
+  name: Unit
+  properties:
+  - name: group
+    type: Ref[Unit]
+`,
+	}
+	if _, err := readTestModel(t, files); err == nil {
+		t.Error("expected an error for a reference type without x-ref class")
+	}
+
+	files["Reference.yaml"] = strings.Replace(files["Reference.yaml"],
+		"  properties:", "  x-ref: true\n  properties:", 1)
+	model, err := readTestModel(t, files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	classes, err := pyClassesOf(model)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buffer bytes.Buffer
+	w := &pyWriter{buff: &buffer, model: model, classes: classes}
+	w.writeModel()
+	module := buffer.String()
+	for _, line := range []string{
+		"class Reference(Generic[_T]):",
+		"    group: Optional[Reference[Unit]] = None",
+		"Reference.from_dict(v, 'Unit')",
+	} {
+		if !strings.Contains(module, line) {
+			t.Error("missing in Python module:", line)
+		}
+	}
+	if strings.Contains(module, "Optional[Ref[") || strings.Contains(module, " Ref.") {
+		t.Error("the reference class should not be named Ref")
+	}
+}
+
+// The elements of lists and maps are only checked if the value is a list or
+// a map.
+func TestPyContainerChecks(t *testing.T) {
+	model, err := readTestModel(t, pyTestFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	classes, err := pyClassesOf(model)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buffer bytes.Buffer
+	w := &pyWriter{buff: &buffer, model: model, classes: classes}
+	w.writeModel()
+	module := buffer.String()
+	for _, lines := range [][]string{
+		{
+			"            if _check_value(self.properties, dict, 'Map[string, double]', 'properties', errors):",
+			"                for k, v in self.properties.items():",
+			"                    _check_value(v, (int, float), 'double', f'properties[{k!r}]', errors)",
+		},
+		{
+			"            if _check_value(self.units, list, 'List[Unit]', 'units', errors):",
+			"                for i, e in enumerate(self.units):",
+		},
+	} {
+		if !strings.Contains(module, strings.Join(lines, "\n")+"\n") {
+			t.Error("missing checks in Python module:", lines[0])
+		}
+	}
+}
+
+// The version of a root entity is initialized with `01.00.000` if the schema
+// has no default value for it.
+func TestPyVersionFallback(t *testing.T) {
+	model, err := readTestModel(t, map[string]string{
+		"RootEntity.yaml": `class:
+  name: RootEntity
+  properties:
+  - name: '@id'
+    type: string
+  - name: version
+    type: string
+  - name: lastChange
+    type: dateTime
+`,
+		"Flow.yaml": `class:
+  name: Flow
+  superClass: RootEntity
+`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := model.ToPyClass(model.TypeMap["Flow"].Class)
+	if !strings.Contains(code, "        if self.version is None:\n"+
+		"            self.version = '01.00.000'\n") {
+		t.Error("missing initialization of the version in __post_init__:\n" + code)
+	}
+}
@@ -0,0 +1,367 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Generates a unittest module for the generated Python module with the given
+// import name. For each concrete class, it builds an instance with sample
+// values for all properties and checks the JSON round trip of that instance
+// and, for root entities, the reference of it. Finally, it checks that falsy
+// values and empty lists survive the round trip, or that empty lists are
+// omitted with `omitEmptyLists`.
+func (w *pyWriter) testModuleOf(module string) string {
+	model := w.model
+	b := NewBuffer()
+	b.Writeln("# DO NOT CHANGE THIS CODE AS THIS IS GENERATED AUTOMATICALLY")
+	b.Writeln()
+	b.Writeln("# This module contains round-trip tests of the classes of the")
+	b.Writeln("# generated module `" + module + "` with sample values of all")
+	b.Writeln("# properties.")
+	b.Writeln()
+	b.Writeln("import datetime")
+	b.Writeln("import json")
+	b.Writeln("import unittest")
+	b.Writeln("import warnings")
+	b.Writeln()
+	b.Writeln("import " + module + " as schema")
+	b.Writeln()
+	b.Writeln()
+	if model.Manifest.Generators.Python.ParseDates {
+		b.Writeln("_DATE = datetime.date(2022, 5, 4)")
+		b.Writeln("_DATE_TIME = datetime.datetime(")
+		b.Writeln(pyInd1 + "2022, 5, 4, 12, 30, tzinfo=datetime.timezone.utc)")
+		b.Writeln()
+		b.Writeln()
+	}
+
+	concrete := w.classes
+	omitEmptyLists := model.Manifest.Generators.Python.OmitEmptyLists
+
+	// the factory functions of the sample instances
+	typeField := ""
+	if ref := model.RefClass(); ref != nil && !model.IsAbstract(ref) {
+		typeField = ref.Annotations.String("x-python-type-field")
+		b.Writeln("def _ref_of(model_type):")
+		b.Writeln(pyInd1 + "ref = _sample_" + toSnakeName(ref.Name) + "()")
+		if typeField != "" {
+			b.Writeln(pyInd1 + "ref." + typeField + " = model_type")
+		}
+		b.Writeln(pyInd1 + "return ref")
+		b.Writeln()
+		b.Writeln()
+	}
+	for _, class := range concrete {
+		b.Writeln("def _sample_" + toSnakeName(class.Name) + "():")
+		b.Writeln(pyInd1 + "return schema." + class.Name + "(")
+		for _, f := range model.pyFieldsOf(class) {
+			t, name := f.prop.PropType(), f.prop.PyName()
+			value := model.pySampleOf(class, t, name, 0)
+			if value == "" {
+				continue
+			}
+			line := pyInd2 + name + "=" + value + ","
+			if len(line) <= 79 || !t.IsList() {
+				b.Writeln(line)
+				continue
+			}
+			b.Writeln(pyInd2 + name + "=[")
+			for _, elem := range model.pySampleElemsOf(class, t, name) {
+				b.Writeln(pyInd3 + elem + ",")
+			}
+			b.Writeln(pyInd2 + "],")
+		}
+		b.Writeln(pyInd1 + ")")
+		b.Writeln()
+		b.Writeln()
+	}
+
+	// the test cases
+	b.Writeln("class GeneratedRoundTripTest(unittest.TestCase):")
+	b.Writeln()
+	b.Writeln(pyInd1 + "def setUp(self):")
+	b.Writeln(pyInd2 + "warnings.simplefilter('ignore', DeprecationWarning)")
+	b.Writeln()
+	b.Writeln(pyInd1 + "def tearDown(self):")
+	b.Writeln(pyInd2 + "warnings.resetwarnings()")
+	for _, class := range concrete {
+		name := toSnakeName(class.Name)
+		b.Writeln()
+		b.Writeln(pyInd1 + "def test_" + name + "(self):")
+		b.Writeln(pyInd2 + name + " = _sample_" + name + "()")
+		b.Writeln(pyInd2 + "self._check_round_trip(" + name + ")")
+		for _, f := range model.pyFieldsOf(class) {
+			t := f.prop.PropType()
+			field := name + "." + f.prop.PyName()
+			switch {
+			case t.IsEnumOf(model):
+				b.Writeln(pyInd2 + "for item in schema." + string(t) + ":")
+				b.Writeln(pyInd3 + field + " = item")
+				b.Writeln(pyInd3 + "self._check_round_trip(" + name + ")")
+			case t.IsList() && t.UnpackList().IsEnumOf(model):
+				b.Writeln(pyInd2 + field + " = list(schema." +
+					string(t.UnpackList()) + ")")
+				b.Writeln(pyInd2 + "self._check_round_trip(" + name + ")")
+			}
+		}
+		if model.IsRoot(class) {
+			b.Writeln(pyInd2 + "self._check_json(" + name + ")")
+		}
+		if typeField != "" && model.HasToRef(class) {
+			b.Writeln(pyInd2 + "self._check_ref(" + name + ", '" + class.Name + "')")
+		}
+
+		// values like 0, False, or '' have to survive the round trip; empty
+		// lists too, unless they are omitted
+		var falsy, emptyLists []string
+		for _, f := range model.pyFieldsOf(class) {
+			t, field := f.prop.PropType(), "'"+f.prop.PyName()+"': "
+			switch {
+			case t.IsList() && omitEmptyLists:
+				emptyLists = append(emptyLists, field+"'"+f.prop.Name+"'")
+			case t.IsList():
+				falsy = append(falsy, field+"[]")
+			default:
+				if value := model.pyFalsyOf(t); value != "" {
+					falsy = append(falsy, field+value)
+				}
+			}
+		}
+		if len(falsy) > 0 {
+			b.Writeln(pyInd2 + "self._check_falsy_values(" + name + ", {")
+			for _, value := range falsy {
+				b.Writeln(pyInd3 + value + ",")
+			}
+			b.Writeln(pyInd2 + "})")
+		}
+		if len(emptyLists) > 0 {
+			b.Writeln(pyInd2 + "self._check_empty_lists(" + name + ", {")
+			for _, list := range emptyLists {
+				b.Writeln(pyInd3 + list + ",")
+			}
+			b.Writeln(pyInd2 + "})")
+		}
+	}
+	b.Writeln()
+	b.Writeln(pyInd1 + "def _check_round_trip(self, instance):")
+	b.Writeln(pyInd2 + "data = json.loads(json.dumps(instance.to_dict()))")
+	b.Writeln(pyInd2 + "clone = type(instance).from_dict(data)")
+	b.Writeln(pyInd2 + "self.assertEqual(instance, clone)")
+	b.Writeln(pyInd2 + "self.assertEqual(instance.to_dict(), clone.to_dict())")
+	b.Writeln()
+	b.Writeln(pyInd1 + "def _check_json(self, instance):")
+	b.Writeln(pyInd2 + "clone = type(instance).from_json(instance.to_json())")
+	b.Writeln(pyInd2 + "self.assertEqual(instance, clone)")
+	b.Writeln()
+	b.Writeln(pyInd1 + "def _check_falsy_values(self, instance, values):")
+	b.Writeln(pyInd2 + "for name, value in values.items():")
+	b.Writeln(pyInd3 + "setattr(instance, name, value)")
+	b.Writeln(pyInd2 + "self._check_round_trip(instance)")
+	b.Writeln(pyInd2 + "data = json.loads(json.dumps(instance.to_dict()))")
+	b.Writeln(pyInd2 + "clone = type(instance).from_dict(data)")
+	b.Writeln(pyInd2 + "for name, value in values.items():")
+	b.Writeln(pyInd3 + "actual = getattr(clone, name)")
+	b.Writeln(pyInd3 + "self.assertEqual((type(value), value), (type(actual), actual))")
+	if omitEmptyLists {
+		b.Writeln()
+		b.Writeln(pyInd1 + "def _check_empty_lists(self, instance, keys):")
+		b.Writeln(pyInd2 + "for name in keys:")
+		b.Writeln(pyInd3 + "setattr(instance, name, [])")
+		b.Writeln(pyInd2 + "data = instance.to_dict()")
+		b.Writeln(pyInd2 + "clone = type(instance).from_dict(data)")
+		b.Writeln(pyInd2 + "for name, key in keys.items():")
+		b.Writeln(pyInd3 + "self.assertNotIn(key, data)")
+		b.Writeln(pyInd3 + "self.assertIsNone(getattr(clone, name))")
+	}
+	if typeField != "" {
+		b.Writeln()
+		b.Writeln(pyInd1 + "def _check_ref(self, instance, model_type):")
+		b.Writeln(pyInd2 + "ref = instance.to_ref()")
+		b.Writeln(pyInd2 + "self.assertEqual(instance.id, ref.id)")
+		b.Writeln(pyInd2 + "self.assertEqual(instance.name, ref.name)")
+		b.Writeln(pyInd2 + "self.assertEqual(model_type, ref." + typeField + ")")
+		b.Writeln(pyInd2 + "self.assertEqual(")
+		b.Writeln(pyInd3 + "getattr(instance, 'category', None), ref.category)")
+		b.Writeln(pyInd2 + "self._check_round_trip(ref)")
+	}
+	b.Writeln()
+	b.Writeln()
+	b.Writeln("if __name__ == '__main__':")
+	b.Writeln(pyInd1 + "unittest.main()")
+	return b.String()
+}
+
+// Returns the Python expression of the i-th sample value of the given type
+// for a property with the given name in the given class. It returns an empty
+// string if no sample value can be created, e.g. for a nested object that
+// would contain the class itself.
+func (model *YamlModel) pySampleOf(
+	class *YamlClass, t YamlPropType, name string, i int) string {
+
+	if t.IsList() {
+		elems := model.pySampleElemsOf(class, t, name)
+		if len(elems) == 0 {
+			return ""
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	}
+
+	if t.IsMap() {
+		_, valueType := t.UnpackMap()
+		first := model.pySampleOf(class, valueType, name, 0)
+		if first == "" {
+			return ""
+		}
+		return "{'a': " + first + ", 'b': " +
+			model.pySampleOf(class, valueType, name, 1) + "}"
+	}
+
+	if t.IsUnion() {
+		alternatives := t.UnpackUnion()
+		if len(alternatives) == 0 {
+			return ""
+		}
+		return model.pySampleOf(class, alternatives[0], name, i)
+	}
+
+	if t.IsRef() {
+		targets := model.pyRefTargetsOf(t.UnpackRef())
+		if len(targets) == 0 {
+			return ""
+		}
+		return "_ref_of('" + targets[0] + "')"
+	}
+
+	if yt := model.TypeMap[string(t)]; yt != nil {
+		if yt.IsEnum() {
+			if len(yt.Enum.Items) == 0 {
+				return ""
+			}
+			return "schema." + yt.Enum.Name + "." + yt.Enum.Items[0].Name
+		}
+		if model.IsAbstract(yt.Class) || model.pyReaches(yt.Class, class) {
+			return ""
+		}
+		return "_sample_" + toSnakeName(yt.Class.Name) + "()"
+	}
+
+	switch model.pyDateKindOf(t) {
+	case "date":
+		return "_DATE"
+	case "dateTime":
+		return "_DATE_TIME"
+	}
+	switch model.Primitives.FormatOf(string(t)) {
+	case "date":
+		return "'2022-05-04'"
+	case "date-time":
+		return "'2022-05-04T12:30:00Z'"
+	}
+	pyType := t.ToPython(model)
+	switch {
+	case pyType == "bool":
+		if i > 0 {
+			return "False"
+		}
+		return "True"
+	case pyType == "int":
+		return strconv.Itoa(42 + i)
+	case pyType == "float":
+		return strconv.Itoa(42+i) + ".5"
+	case strings.HasPrefix(pyType, "Dict["):
+		return "{'type': 'Point', 'coordinates': [13.4, 52.5]}"
+	default:
+		if i > 0 {
+			return pyStringOf(name + " " + strconv.Itoa(i+1))
+		}
+		return pyStringOf(name)
+	}
+}
+
+// Returns the Python expression of the falsy value of the given scalar type,
+// like `0` or `False`, or an empty string if the type is not a scalar type.
+func (model *YamlModel) pyFalsyOf(t YamlPropType) string {
+	if t.IsList() || t.IsMap() || t.IsUnion() || t.IsRef() ||
+		model.TypeMap[string(t)] != nil || model.pyDateKindOf(t) != "" {
+		return ""
+	}
+	switch t.ToPython(model) {
+	case "bool":
+		return "False"
+	case "int":
+		return "0"
+	case "float":
+		return "0.0"
+	case "str":
+		return "''"
+	default:
+		return ""
+	}
+}
+
+// Returns the two sample elements of the given list type, or nil if no sample
+// elements can be created. For enumerations, these are the first two items.
+func (model *YamlModel) pySampleElemsOf(
+	class *YamlClass, t YamlPropType, name string) []string {
+	elemType := t.UnpackList()
+	if yt := model.TypeMap[string(elemType)]; yt != nil && yt.IsEnum() {
+		items := yt.Enum.Items
+		if len(items) == 0 {
+			return nil
+		}
+		return []string{
+			"schema." + yt.Enum.Name + "." + items[0].Name,
+			"schema." + yt.Enum.Name + "." + items[1%len(items)].Name,
+		}
+	}
+	first := model.pySampleOf(class, elemType, name, 0)
+	if first == "" {
+		return nil
+	}
+	return []string{first, model.pySampleOf(class, elemType, name, 1)}
+}
+
+// Returns true if the given class can contain an instance of the target class
+// in its properties, directly or in nested objects.
+func (model *YamlModel) pyReaches(class, target *YamlClass) bool {
+	index := model.Index()
+	visited := make(map[*YamlClass]bool)
+	var visit func(c *YamlClass) bool
+	visit = func(c *YamlClass) bool {
+		if c == target {
+			return true
+		}
+		if visited[c] {
+			return false
+		}
+		visited[c] = true
+		for _, dep := range index.DependenciesOf(c) {
+			if visit(dep) {
+				return true
+			}
+		}
+		return false
+	}
+	return visit(class)
+}
+
+// Writes the generated tests of the module with the given import name into
+// the given file.
+func (w *pyWriter) writeTests(file, module string) {
+	writeFile(file, w.testModuleOf(module))
+}
+
+// Returns the import name of the generated Python module in the given file:
+// it is prefixed with the name of the package if the file is located in a
+// package folder.
+func pyImportNameOf(file string) string {
+	dir, name := filepath.Split(file)
+	module := strings.TrimSuffix(name, ".py")
+	if _, err := os.Stat(filepath.Join(dir, "__init__.py")); dir == "" || err != nil {
+		return module
+	}
+	return filepath.Base(filepath.Clean(dir)) + "." + module
+}
@@ -0,0 +1,142 @@
+package main
+
+import "strings"
+
+// Generates the `zipio` module with the reader and writer of zip packages for
+// the root entities of the model. The classes are imported from the given
+// module of the same package. With `stub`, the typing stub of the module is
+// generated, with the signatures of the public classes and methods only.
+func (w *pyWriter) zipModuleOf(mainModule string, stub bool) string {
+	var roots []*YamlClass
+	w.model.EachClass(func(class *YamlClass) {
+		if w.model.IsRoot(class) && !w.model.IsAbstract(class) {
+			roots = append(roots, class)
+		}
+	})
+
+	b := NewBuffer()
+	def := func(signature string, body ...string) {
+		if stub {
+			b.Writeln(pyInd1 + signature + " ...")
+			return
+		}
+		b.Writeln(pyInd1 + signature)
+		for _, line := range body {
+			b.Writeln(pyInd2 + line)
+		}
+		b.Writeln()
+	}
+
+	b.Writeln("# DO NOT CHANGE THIS CODE AS THIS IS GENERATED AUTOMATICALLY")
+	b.Writeln()
+	b.Writeln("# This module contains the reader and writer of zip packages with data sets")
+	b.Writeln("# in the openLCA schema format. The data sets are stored in folders by type.")
+	b.Writeln()
+	if !stub {
+		b.Writeln("import json")
+		b.Writeln("import zipfile")
+		b.Writeln()
+	}
+	b.Writeln("from typing import Any, Dict, Iterator, List, Optional, Type, TypeVar")
+	b.Writeln()
+	if mainModule == "schema" {
+		b.Writeln("from . import schema")
+	} else {
+		b.Writeln("from . import " + mainModule + " as schema")
+	}
+	b.Writeln()
+	b.Writeln("E = TypeVar('E')")
+	b.Writeln()
+	if !stub {
+		b.Writeln("_FOLDERS: Dict[type, str] = {")
+		for _, class := range roots {
+			b.Writeln(pyInd1 + "schema." + class.Name + ": " +
+				pyStringOf(class.ZipFolder()) + ",")
+		}
+		b.Writeln("}")
+		b.Writeln()
+	}
+	b.Writeln()
+
+	// writer
+	b.Writeln("class ZipWriter:")
+	if !stub {
+		b.Writeln()
+	}
+	def("def __init__(self, file_name: str) -> None:",
+		"self.__zip = zipfile.ZipFile(",
+		pyInd1+"file_name, mode='a', compression=zipfile.ZIP_DEFLATED)",
+		"if 'olca-schema.json' not in self.__zip.namelist():",
+		pyInd1+"self.__zip.writestr('olca-schema.json', json.dumps({",
+		pyInd2+"'version': 2,",
+		pyInd2+"'schemaVersion': schema.SCHEMA_VERSION,",
+		pyInd1+"}))")
+	writeContextMethods(def, "ZipWriter")
+	def("def write(self, entity: schema.RootEntity) -> None:",
+		"if entity.id is None or entity.id == '':",
+		pyInd1+"raise ValueError('entity must have an ID')",
+		"folder = _folder_of_class(type(entity))",
+		"path = f'{folder}/{entity.id}.json'",
+		"self.__zip.writestr(path, entity.to_json())")
+	b.Writeln()
+
+	// reader
+	b.Writeln("class ZipReader:")
+	if !stub {
+		b.Writeln()
+	}
+	def("def __init__(self, file_name: str) -> None:",
+		"self.__zip = zipfile.ZipFile(file_name, mode='r')")
+	writeContextMethods(def, "ZipReader")
+	def("def read(self, class_type: Type[E], uid: str) -> Optional[E]:",
+		"folder = _folder_of_class(class_type)",
+		"path = f'{folder}/{uid}.json'",
+		"if path not in self.__zip.namelist():",
+		pyInd1+"return None",
+		"data = self.__zip.read(path)",
+		"return class_type.from_json(data)  # type: ignore")
+	def("def ids(self, class_type: type) -> List[str]:",
+		"folder = _folder_of_class(class_type)",
+		"ids: List[str] = []",
+		"for path in self.__zip.namelist():",
+		pyInd1+"if path.startswith(folder + '/') and path.endswith('.json'):",
+		pyInd2+"ids.append(path[len(folder) + 1:-5])",
+		"return ids")
+	def("def iter_all(self, class_type: Type[E]) -> Iterator[E]:",
+		"for uid in self.ids(class_type):",
+		pyInd1+"entity = self.read(class_type, uid)",
+		pyInd1+"if entity is not None:",
+		pyInd2+"yield entity")
+	def("def read_all(self, class_type: Type[E]) -> List[E]:",
+		"return list(self.iter_all(class_type))")
+	for _, class := range roots {
+		name := toSnakeName(class.Name)
+		plural := toPlural(name)
+		t := "schema." + class.Name
+		def("def read_"+name+"(self, uid: str) -> Optional["+t+"]:",
+			"return self.read("+t+", uid)")
+		def("def iter_"+plural+"(self) -> Iterator["+t+"]:",
+			"return self.iter_all("+t+")")
+		def("def read_all_"+plural+"(self) -> List["+t+"]:",
+			"return self.read_all("+t+")")
+	}
+	if stub {
+		return b.String()
+	}
+	b.Writeln()
+
+	b.Writeln("def _folder_of_class(t: type) -> str:")
+	b.Writeln(pyInd1 + "folder = _FOLDERS.get(t)")
+	b.Writeln(pyInd1 + "if folder is None:")
+	b.Writeln(pyInd2 + "raise ValueError(f'not a known root entity type: {t}')")
+	b.Writeln(pyInd1 + "return folder")
+	return strings.TrimSuffix(b.String(), "\n") + "\n"
+}
+
+// Writes the methods of a class that opens and closes a zip file with the
+// given function that writes a method.
+func writeContextMethods(def func(signature string, body ...string), class string) {
+	def("def __enter__(self) -> '"+class+"':", "return self")
+	def("def __exit__(self, *args: Any) -> None:", "self.close()")
+	def("def close(self) -> None:", "self.__zip.close()")
+}
@@ -2,21 +2,28 @@ package main
 
 import (
 	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 )
 
 func checkSchema(args *args) {
-	model, err := ReadYamlModel(args.yamlDir)
+	model, err := readModel(args)
 	if err != nil {
 		fmt.Println("ERROR: Failed to parse YAML model:", err)
 		return
 	}
 
 	checkClassHierarchy(model)
+	checkMixins(model)
 	checkBooleanPrefixes(model)
 	checkPropertyOrder(model)
 	checkFieldIndices(model)
+	checkConstraints(model)
+	checkReplacements(model)
+	checkUnions(model)
+	checkPrimitives(model)
+	checkAnnotations(model)
 }
 
 func checkClassHierarchy(model *YamlModel) {
@@ -26,6 +33,10 @@ func checkClassHierarchy(model *YamlModel) {
 			continue
 		}
 		class := t.Class
+		if model.IsMixin(class) {
+			// mixins are not part of the class hierarchy
+			continue
+		}
 		for {
 			if class.Name == "Entity" {
 				break
@@ -41,6 +52,34 @@ func checkClassHierarchy(model *YamlModel) {
 	}
 }
 
+func checkMixins(model *YamlModel) {
+	for _, t := range model.Types {
+		if t.IsEnum() {
+			continue
+		}
+		class := t.Class
+		for _, name := range class.Mixins {
+			mixin := model.TypeMap[name]
+			if mixin == nil || !mixin.IsClass() {
+				fmt.Println("ERROR: mixin '" + name + "' of class '" +
+					class.Name + "' is not a known class")
+			}
+		}
+
+		if !model.IsMixin(class) {
+			continue
+		}
+		if !class.Abstract {
+			fmt.Println("WARNING: mixin '" + class.Name +
+				"' should be marked as abstract")
+		}
+		if class.SuperClass != "" {
+			fmt.Println("WARNING: mixin '" + class.Name +
+				"' has a super class which is ignored when it is included")
+		}
+	}
+}
+
 func checkBooleanPrefixes(model *YamlModel) {
 	boolPrefs := []string{
 		"has", "is", "with",
@@ -77,7 +116,13 @@ func checkPropertyOrder(model *YamlModel) {
 			continue
 		}
 		c := t.Class
-		props := c.Props
+		props := make([]*YamlProp, 0, len(c.Props))
+		for _, prop := range c.Props {
+			// properties of overlays are appended to the class
+			if prop.Origin == "" {
+				props = append(props, prop)
+			}
+		}
 		sorted := true
 		var last *YamlProp
 		for i := range props {
@@ -152,4 +197,373 @@ func checkFieldIndices(model *YamlModel) {
 		}
 	}
 
+	checkOverlayIndices(model)
+}
+
+// Checks that the overlays have valid and disjoint index ranges, that the
+// properties and items of an overlay are in the index range of that overlay,
+// and that the items of the base schema are not in the index range of an
+// overlay. The properties of the base schema are numbered consecutively in
+// proto3, so for them the emitted field numbers are checked instead of their
+// indices: they must be unique in a message and not in the index range of an
+// overlay or the reserved range.
+func checkOverlayIndices(model *YamlModel) {
+	overlays := make(map[string]*YamlOverlay)
+	for i, o := range model.Overlays {
+		overlays[o.Name] = o
+		if o.MinIndex < 1 || o.MaxIndex < o.MinIndex {
+			fmt.Println("ERROR: invalid index range", o.MinIndex, "-", o.MaxIndex,
+				"of overlay", o.Name)
+			continue
+		}
+		if o.MinIndex <= 19999 && o.MaxIndex >= 19000 {
+			fmt.Println("ERROR: the index range of overlay", o.Name,
+				"contains the reserved proto3 field numbers 19000-19999")
+		}
+		for _, other := range model.Overlays[:i] {
+			if o.MinIndex <= other.MaxIndex && other.MinIndex <= o.MaxIndex {
+				fmt.Println("ERROR: the index ranges of the overlays", other.Name,
+					"and", o.Name, "overlap")
+			}
+		}
+	}
+
+	check := func(element string, origin string, first, last int) {
+		if origin != "" {
+			o := overlays[origin]
+			if !o.InRange(first) || !o.InRange(last) {
+				fmt.Println("ERROR: index", first, "of", element,
+					"is not in the index range of overlay", origin)
+			}
+			return
+		}
+		for _, o := range model.Overlays {
+			if o.InRange(first) || o.InRange(last) {
+				fmt.Println("ERROR: index", first, "of", element,
+					"is in the index range of overlay", o.Name)
+			}
+		}
+	}
+
+	for _, t := range model.Types {
+		if t.IsEnum() {
+			for _, item := range t.Enum.Items {
+				origin := item.Origin
+				if origin == "" {
+					origin = t.Origin
+				}
+				check("item "+item.Name+" in enum "+t.Name(), origin,
+					item.Index, item.Index)
+			}
+			continue
+		}
+		for _, prop := range t.Class.Props {
+			origin := prop.Origin
+			if origin == "" {
+				origin = t.Origin
+			}
+			if origin == "" {
+				continue
+			}
+			last := prop.Index
+			if propType := prop.PropType(); propType.IsUnion() {
+				// the alternatives of a union are numbered consecutively
+				last += len(propType.UnpackUnion()) - 1
+			}
+			check("property "+prop.Name+" in class "+t.Name(), origin,
+				prop.Index, last)
+		}
+	}
+
+	for _, t := range model.Types {
+		if !t.IsClass() || t.Class.Abstract ||
+			t.Class.Annotations.Bool("x-proto-skip") {
+			continue
+		}
+		numbers, err := model.protoNumbersOf(t.Class)
+		if err != nil {
+			fmt.Println("ERROR:", err)
+			continue
+		}
+		for _, prop := range model.protoPropsOf(t.Class) {
+			if prop.Origin != "" {
+				continue
+			}
+			first := numbers[prop]
+			last := first + protoWidthOf(prop) - 1
+			if first <= 19999 && last >= 19000 {
+				fmt.Println("ERROR: field number", first, "of property", prop.Name,
+					"in the message of class", t.Name(),
+					"is in the reserved proto3 range 19000-19999")
+			}
+			for _, o := range model.Overlays {
+				if o.InRange(first) || o.InRange(last) {
+					fmt.Println("ERROR: field number", first, "of property",
+						prop.Name, "in the message of class", t.Name(),
+						"is in the index range of overlay", o.Name)
+				}
+			}
+		}
+	}
+}
+
+func checkConstraints(model *YamlModel) {
+	for _, t := range model.Types {
+		if t.IsEnum() {
+			continue
+		}
+		for _, prop := range t.Class.Props {
+			c := prop.Constraints
+			if c == nil {
+				continue
+			}
+			err := func(msg string) {
+				fmt.Println("ERROR: constraint of property '" + prop.Name +
+					"' in class '" + t.Name() + "': " + msg)
+			}
+
+			propType := prop.PropType()
+			isContainer := propType.IsList() || propType.IsMap()
+			valueType := propType
+			if propType.IsList() {
+				valueType = propType.UnpackList()
+			} else if propType.IsMap() {
+				_, valueType = propType.UnpackMap()
+			}
+
+			hasRange := c.Min != nil || c.Max != nil ||
+				c.ExclusiveMin != nil || c.ExclusiveMax != nil
+			if hasRange && !valueType.IsNumericOf(model) {
+				err("a value range is only allowed for numeric types")
+			}
+			if c.Min != nil && c.Max != nil && *c.Min > *c.Max {
+				err("the minimum is larger than the maximum")
+			}
+			if c.Pattern != "" {
+				if !valueType.IsTextOf(model) {
+					err("a pattern is only allowed for string types")
+				} else if _, e := regexp.Compile(c.Pattern); e != nil {
+					err("invalid pattern: " + e.Error())
+				}
+			}
+			hasLength := c.MinLength != nil || c.MaxLength != nil
+			if hasLength && !isContainer && !valueType.IsTextOf(model) {
+				err("a length is only allowed for string, list, or map types")
+			}
+			if c.MinLength != nil && c.MaxLength != nil &&
+				*c.MinLength > *c.MaxLength {
+				err("the min. length is larger than the max. length")
+			}
+		}
+	}
+}
+
+// Checks that the `replacedBy` references of deprecated elements point to
+// existing elements: types are replaced by types, properties by properties of
+// the same class (or `Class.property` of another class), and enumeration
+// items by items of the same enumeration.
+func checkReplacements(model *YamlModel) {
+
+	check := func(element string, v *YamlVersioning, exists func(string) bool) {
+		if v.ReplacedBy == "" {
+			return
+		}
+		if !v.IsDeprecated() {
+			fmt.Println("WARNING: " + element + " has a replacement '" +
+				v.ReplacedBy + "' but is not deprecated")
+		}
+		if !exists(v.ReplacedBy) {
+			fmt.Println("ERROR: the replacement '" + v.ReplacedBy + "' of " +
+				element + " does not exist")
+		}
+	}
+
+	typeExists := func(name string) bool {
+		return model.TypeMap[name] != nil
+	}
+
+	propExists := func(class *YamlClass, name string) bool {
+		if i := strings.Index(name, "."); i >= 0 {
+			t := model.TypeMap[name[:i]]
+			if t == nil || !t.IsClass() {
+				return false
+			}
+			class = t.Class
+			name = name[i+1:]
+		}
+		for _, prop := range model.AllPropsOf(class) {
+			if prop.Name == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, t := range model.Types {
+		if t.IsEnum() {
+			enum := t.Enum
+			check("enum '"+enum.Name+"'", &enum.YamlVersioning, typeExists)
+			itemExists := func(name string) bool {
+				for _, item := range enum.Items {
+					if item.Name == name {
+						return true
+					}
+				}
+				return false
+			}
+			for _, item := range enum.Items {
+				check("item '"+item.Name+"' in enum '"+enum.Name+"'",
+					&item.YamlVersioning, itemExists)
+			}
+			continue
+		}
+
+		class := t.Class
+		check("class '"+class.Name+"'", &class.YamlVersioning, typeExists)
+		for _, prop := range class.Props {
+			check("property '"+prop.Name+"' in class '"+class.Name+"'",
+				&prop.YamlVersioning, func(name string) bool {
+					return propExists(class, name)
+				})
+		}
+	}
+}
+
+// Checks that the alternatives of union types can be distinguished by their
+// `@type` attribute: they have to be unique and non-abstract classes. A `Ref`
+// is not allowed as its `@type` is the type of the referenced entity.
+func checkUnions(model *YamlModel) {
+	for _, t := range model.Types {
+		if t.IsEnum() {
+			continue
+		}
+		for _, prop := range t.Class.Props {
+			propType := prop.PropType()
+			if !propType.IsUnion() {
+				continue
+			}
+			err := func(msg string) {
+				fmt.Println("ERROR: union type of property '" + prop.Name +
+					"' in class '" + t.Name() + "': " + msg)
+			}
+			seen := make(map[YamlPropType]bool)
+			for _, alt := range propType.UnpackUnion() {
+				if seen[alt] {
+					err("duplicate alternative '" + string(alt) + "'")
+					continue
+				}
+				seen[alt] = true
+				if !alt.IsClassOf(model) {
+					err("alternative '" + string(alt) + "' is not a class")
+					continue
+				}
+				class := model.TypeMap[string(alt)].Class
+				if class.IsRef() {
+					err("a reference cannot be distinguished by its @type")
+					continue
+				}
+				if model.IsAbstract(class) {
+					err("alternative '" + string(alt) + "' is an abstract class")
+				}
+			}
+		}
+	}
+}
+
+// Checks the registered primitive types and that the types of all properties
+// are either primitive types, classes, or enumerations.
+func checkPrimitives(model *YamlModel) {
+	names := make([]string, 0, len(model.Primitives))
+	for name := range model.Primitives {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if model.TypeMap[name] != nil {
+			fmt.Println("ERROR: the primitive type '" + name +
+				"' has the same name as a class or enumeration")
+		}
+		pattern := model.Primitives[name].Pattern
+		if pattern == "" {
+			continue
+		}
+		if model.Primitives.RootOf(name) != "string" {
+			fmt.Println("ERROR: the primitive type '" + name +
+				"' has a pattern but it is not a string type")
+		} else if _, e := regexp.Compile(pattern); e != nil {
+			fmt.Println("ERROR: invalid pattern of primitive type '" + name +
+				"': " + e.Error())
+		}
+	}
+
+	var check func(t YamlPropType) []YamlPropType
+	check = func(t YamlPropType) []YamlPropType {
+		switch {
+		case t.IsList():
+			return check(t.UnpackList())
+		case t.IsRef():
+			// in a profile, references can point to types outside of it
+			if model.Profile != "" {
+				return nil
+			}
+			return check(t.UnpackRef())
+		case t.IsMap():
+			key, value := t.UnpackMap()
+			return append(check(key), check(value)...)
+		case t.IsUnion():
+			var unknown []YamlPropType
+			for _, alt := range t.UnpackUnion() {
+				unknown = append(unknown, check(alt)...)
+			}
+			return unknown
+		case t.IsPrimitiveOf(model) || model.TypeMap[string(t)] != nil:
+			return nil
+		default:
+			return []YamlPropType{t}
+		}
+	}
+	for _, t := range model.Types {
+		if t.IsEnum() {
+			continue
+		}
+		for _, prop := range t.Class.Props {
+			for _, unknown := range check(prop.PropType()) {
+				fmt.Println("ERROR: unknown type '" + string(unknown) +
+					"' of property '" + prop.Name + "' in class '" + t.Name() + "'")
+			}
+		}
+	}
+}
+
+// Checks the annotations of the schema elements and that the generated Python
+// and proto3 names of the properties are valid identifiers.
+func checkAnnotations(model *YamlModel) {
+	report := func(element string, a YamlAnnotations, kind string) {
+		for _, problem := range a.Validate(kind) {
+			fmt.Println("ERROR: " + element + ": " + problem)
+		}
+	}
+	for _, t := range model.Types {
+		if t.IsEnum() {
+			enum := t.Enum
+			report("enum '"+enum.Name+"'", enum.Annotations, "enum")
+			for _, item := range enum.Items {
+				report("item '"+item.Name+"' of enum '"+enum.Name+"'",
+					item.Annotations, "item")
+			}
+			continue
+		}
+		class := t.Class
+		report("class '"+class.Name+"'", class.Annotations, "class")
+		for _, prop := range class.Props {
+			element := "property '" + prop.Name + "' in class '" + class.Name + "'"
+			report(element, prop.Annotations, "property")
+			if err := prop.checkPyName(); err != nil {
+				fmt.Println("ERROR: " + element + ": " + err.Error())
+			}
+			if err := prop.checkProtoName(); err != nil {
+				fmt.Println("ERROR: " + element + ": " + err.Error())
+			}
+		}
+	}
 }
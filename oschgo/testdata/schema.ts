@@ -0,0 +1,126 @@
+// DO NOT CHANGE THIS CODE AS THIS IS GENERATED AUTOMATICALLY
+//
+// This module contains the TypeScript types of the JSON based openLCA data
+// exchange format. For more information see
+// http://greendelta.github.io/olca-schema
+//
+// Schema version: 1.0.0
+
+export const SCHEMA_VERSION = '1.0.0';
+
+function isObject(x: unknown): x is Record<string, unknown> {
+  return typeof x === 'object' && x !== null && !Array.isArray(x);
+}
+
+/** The type of a flow. */
+export type FlowType =
+  | 'ELEMENTARY_FLOW'
+  | 'PRODUCT_FLOW';
+
+/** The values of FlowType. */
+export const FLOW_TYPE_VALUES: readonly FlowType[] = [
+  'ELEMENTARY_FLOW',
+  'PRODUCT_FLOW',
+];
+
+/** Returns true if the given value is of type FlowType. */
+export function isFlowType(x: unknown): x is FlowType {
+  return (FLOW_TYPE_VALUES as readonly unknown[]).includes(x);
+}
+
+/** A flow is an input or output of a process. */
+export interface Flow {
+  '@type': 'Flow';
+  '@id': string;
+  category?: string;
+  flowType?: FlowType;
+  /**
+   * @deprecated Flow.formula is deprecated: 1.1.
+   */
+  formula?: string;
+  lastChange?: string;
+  /** The name of the entity. */
+  name: string;
+  properties?: Record<string, number>;
+  tags?: string[];
+}
+
+/** Returns true if the given value is of type Flow. */
+export function isFlow(x: unknown): x is Flow {
+  return isObject(x)
+    && x['@type'] === 'Flow'
+    && x['@id'] != null
+    && x['name'] != null;
+}
+
+export interface Ref<T extends { '@type'?: string } = RootEntity> {
+  '@type'?: T['@type'];
+  '@id': string;
+  category?: string;
+  flowType?: FlowType;
+  /** The name of the entity. */
+  name: string;
+}
+
+/** Returns true if the given value is of type Ref. */
+export function isRef(x: unknown): x is Ref<{ '@type'?: string }> {
+  return isObject(x)
+    && x['@id'] != null
+    && x['name'] != null;
+}
+
+export interface Unit {
+  '@type': 'Unit';
+  '@id': string;
+  conversionFactor: number;
+  isRefUnit?: boolean;
+  /** The name of the entity. */
+  name: string;
+}
+
+/** Returns true if the given value is of type Unit. */
+export function isUnit(x: unknown): x is Unit {
+  return isObject(x)
+    && x['@type'] === 'Unit'
+    && x['@id'] != null
+    && x['conversionFactor'] != null
+    && x['name'] != null;
+}
+
+export interface UnitGroup {
+  '@type': 'UnitGroup';
+  '@id': string;
+  category?: string;
+  lastChange?: string;
+  /** The name of the entity. */
+  name: string;
+  refFlow?: Ref<Flow>;
+  source?: Flow | Unit;
+  tags?: string[];
+  units?: Unit[];
+}
+
+/** Returns true if the given value is of type UnitGroup. */
+export function isUnitGroup(x: unknown): x is UnitGroup {
+  return isObject(x)
+    && x['@type'] === 'UnitGroup'
+    && x['@id'] != null
+    && x['name'] != null;
+}
+
+/** The root entities of the schema, discriminated by their `@type`. */
+export type RootEntity =
+  | Flow
+  | UnitGroup;
+
+/** The `@type` values of the root entities. */
+export const ROOT_ENTITY_TYPES = [
+  'Flow',
+  'UnitGroup',
+] as const;
+
+/** Returns true if the given value is a root entity. */
+export function isRootEntity(x: unknown): x is RootEntity {
+  return isObject(x)
+    && (ROOT_ENTITY_TYPES as readonly unknown[]).includes(x['@type']);
+}
@@ -0,0 +1,367 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+)
+
+// indentation of the generated TypeScript code
+const tsInd = "  "
+
+// the type of the type parameter of references: a type with a `@type` field
+const tsTyped = "{ '@type'?: string }"
+
+var tsIdentifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+func writeTypeScript(args *args) {
+	model, err := readModel(args)
+	check(err, "could not read YAML model")
+
+	enums := false
+	switch args.flavor {
+	case "", "union":
+	case "enum":
+		enums = true
+	default:
+		fmt.Println("ERROR: unknown TypeScript flavor:", args.flavor)
+		return
+	}
+
+	module := GenTypeScript(model, enums)
+	if args.target == "" {
+		fmt.Println(module)
+		return
+	}
+	writeFile(args.target, module)
+}
+
+// GenTypeScript generates a TypeScript module with the types of the given
+// model: a string union (or an `enum` if enums is true) for each enumeration,
+// an interface for each concrete class, the `RootEntity` union that is
+// discriminated on `@type`, and type guards for all of them.
+func GenTypeScript(model *YamlModel, enums bool) string {
+	b := NewBuffer()
+	manifest := model.Manifest
+	b.Writeln("// DO NOT CHANGE THIS CODE AS THIS IS GENERATED AUTOMATICALLY")
+	b.Writeln("//")
+	b.Writeln("// This module contains the TypeScript types of the JSON based openLCA data")
+	b.Writeln("// exchange format. For more information see")
+	b.Writeln("// " + manifest.BaseUrl)
+	if manifest.Version != "" {
+		b.Writeln("//")
+		b.Writeln("// Schema version: " + manifest.Version)
+	}
+	if manifest.License != "" {
+		b.Writeln("// License: " + manifest.License)
+	}
+	b.Writeln()
+	b.Writeln("export const SCHEMA_VERSION = " + tsStringOf(manifest.Version) + ";")
+	b.Writeln()
+	b.Writeln("function isObject(x: unknown): x is Record<string, unknown> {")
+	b.Writeln(tsInd + "return typeof x === 'object' && x !== null && !Array.isArray(x);")
+	b.Writeln("}")
+
+	model.EachEnum(func(enum *YamlEnum) {
+		b.Writeln()
+		tsWriteEnum(b, enum, enums)
+	})
+
+	var roots []string
+	for _, t := range model.Types {
+		if !t.IsClass() || model.IsAbstract(t.Class) {
+			continue
+		}
+		class := t.Class
+		if model.IsRoot(class) {
+			roots = append(roots, class.Name)
+		}
+		b.Writeln()
+		model.tsWriteInterface(b, class)
+		b.Writeln()
+		model.tsWriteGuard(b, class)
+	}
+
+	if len(roots) > 0 {
+		b.Writeln()
+		b.Writeln("/** The root entities of the schema, discriminated by their `@type`. */")
+		b.Writeln("export type RootEntity =")
+		for i, root := range roots {
+			end := ""
+			if i == len(roots)-1 {
+				end = ";"
+			}
+			b.Writeln(tsInd + "| " + root + end)
+		}
+		b.Writeln()
+		b.Writeln("/** The `@type` values of the root entities. */")
+		b.Writeln("export const ROOT_ENTITY_TYPES = [")
+		for _, root := range roots {
+			b.Writeln(tsInd + tsStringOf(root) + ",")
+		}
+		b.Writeln("] as const;")
+		b.Writeln()
+		b.Writeln("/** Returns true if the given value is a root entity. */")
+		b.Writeln("export function isRootEntity(x: unknown): x is RootEntity {")
+		b.Writeln(tsInd + "return isObject(x)")
+		b.Writeln(tsInd + tsInd + "&& (ROOT_ENTITY_TYPES as readonly unknown[]).includes(x['@type']);")
+		b.Writeln("}")
+	}
+	return b.String()
+}
+
+// Returns true if the model contains concrete root entities.
+func (model *YamlModel) tsHasRoots() bool {
+	for _, t := range model.Types {
+		if t.IsClass() && !model.IsAbstract(t.Class) && model.IsRoot(t.Class) {
+			return true
+		}
+	}
+	return false
+}
+
+// Writes the type of the given enumeration with its type guard. As string
+// union, the values are additionally exported as constant array.
+func tsWriteEnum(b *Buffer, enum *YamlEnum, asEnum bool) {
+	if doc := tsDocOf(enum.Doc, enum.DeprecationNote(enum.Name), ""); doc != "" {
+		b.Writeln(doc)
+	}
+	values := strings.ToUpper(toSnakeName(enum.Name)) + "_VALUES"
+	if asEnum {
+		b.Writeln("export enum " + enum.Name + " {")
+		for _, item := range enum.Items {
+			note := item.DeprecationNote(enum.Name + "." + item.Name)
+			if doc := tsDocOf(item.Doc, note, tsInd); doc != "" {
+				b.Writeln(doc)
+			}
+			b.Writeln(tsInd + item.Name + " = " + tsStringOf(item.Name) + ",")
+		}
+		b.Writeln("}")
+	} else {
+		b.Writeln("export type " + enum.Name + " =")
+		for i, item := range enum.Items {
+			note := item.DeprecationNote(enum.Name + "." + item.Name)
+			if doc := tsDocOf(item.Doc, note, tsInd); doc != "" {
+				b.Writeln(doc)
+			}
+			end := ""
+			if i == len(enum.Items)-1 {
+				end = ";"
+			}
+			b.Writeln(tsInd + "| " + tsStringOf(item.Name) + end)
+		}
+		b.Writeln()
+		b.Writeln("/** The values of " + enum.Name + ". */")
+		b.Writeln("export const " + values + ": readonly " + enum.Name + "[] = [")
+		for _, item := range enum.Items {
+			b.Writeln(tsInd + tsStringOf(item.Name) + ",")
+		}
+		b.Writeln("];")
+	}
+
+	b.Writeln()
+	b.Writeln("/** Returns true if the given value is of type " + enum.Name + ". */")
+	b.Writeln("export function is" + enum.Name + "(x: unknown): x is " + enum.Name + " {")
+	if asEnum {
+		b.Writeln(tsInd + "return (Object.values(" + enum.Name + ") as unknown[]).includes(x);")
+	} else {
+		b.Writeln(tsInd + "return (" + values + " as readonly unknown[]).includes(x);")
+	}
+	b.Writeln("}")
+}
+
+// Writes the interface of the given class with the JSON names of its
+// properties. The `@type` of a class is a literal of the class name, except
+// for references (`x-ref`) where it is the type of the referenced entity.
+func (model *YamlModel) tsWriteInterface(b *Buffer, class *YamlClass) {
+	if doc := tsDocOf(class.Doc, class.DeprecationNote(class.Name), ""); doc != "" {
+		b.Writeln(doc)
+	}
+	if class.IsRef() {
+		param := "T extends " + tsTyped
+		if model.tsHasRoots() {
+			param += " = RootEntity"
+		}
+		b.Writeln("export interface " + class.Name + "<" + param + "> {")
+	} else {
+		b.Writeln("export interface " + class.Name + " {")
+	}
+	for _, prop := range model.AllPropsOf(class) {
+		note := prop.DeprecationNote(class.Name + "." + prop.Name)
+		if doc := tsDocOf(prop.Doc, note, tsInd); doc != "" {
+			b.Writeln(doc)
+		}
+		optional := "?"
+		if prop.Required || model.tsIsDiscriminated(class, prop) {
+			optional = ""
+		}
+		var tsType string
+		switch {
+		case prop.Name == "@type" && class.IsRef():
+			tsType = "T['@type']"
+		case prop.Name == "@type":
+			tsType = tsStringOf(class.Name)
+		default:
+			tsType = model.tsTypeOf(prop.PropType())
+		}
+		b.Writeln(tsInd + tsKeyOf(prop.Name) + optional + ": " + tsType + ";")
+	}
+	b.Writeln("}")
+}
+
+// Returns true if the given property is the `@type` of a class that is part of
+// a discriminated union, which is the case for root entities and the members
+// of union types.
+func (model *YamlModel) tsIsDiscriminated(class *YamlClass, prop *YamlProp) bool {
+	return prop.Name == "@type" &&
+		(model.IsRoot(class) || model.IsUnionMember(class))
+}
+
+// Writes the type guard of the given class. It checks the `@type` of the
+// value, which may be missing in nested objects, and the presence of the
+// required properties.
+func (model *YamlModel) tsWriteGuard(b *Buffer, class *YamlClass) {
+	guardType := class.Name
+	if class.IsRef() {
+		guardType = class.Name + "<" + tsTyped + ">"
+	}
+	var conditions []string
+	for _, prop := range model.AllPropsOf(class) {
+		key := tsStringOf(prop.Name)
+		switch {
+		case prop.Name == "@type" && class.IsRef():
+		case model.tsIsDiscriminated(class, prop):
+			conditions = append(conditions,
+				"x["+key+"] === "+tsStringOf(class.Name))
+		case prop.Name == "@type":
+			conditions = append(conditions,
+				"(x["+key+"] === undefined || x["+key+"] === "+
+					tsStringOf(class.Name)+")")
+		case prop.Required:
+			conditions = append(conditions, "x["+key+"] != null")
+		}
+	}
+
+	b.Writeln("/** Returns true if the given value is of type " + class.Name + ". */")
+	b.Writeln("export function is" + class.Name +
+		"(x: unknown): x is " + guardType + " {")
+	if len(conditions) == 0 {
+		b.Writeln(tsInd + "return isObject(x);")
+	} else {
+		b.Writeln(tsInd + "return isObject(x)")
+		for i, condition := range conditions {
+			end := ""
+			if i == len(conditions)-1 {
+				end = ";"
+			}
+			b.Writeln(tsInd + tsInd + "&& " + condition + end)
+		}
+	}
+	b.Writeln("}")
+}
+
+// Returns the TypeScript type of the given property type.
+func (model *YamlModel) tsTypeOf(t YamlPropType) string {
+	if t.IsList() {
+		elem := model.tsTypeOf(t.UnpackList())
+		if strings.ContainsAny(elem, " <") {
+			return "Array<" + elem + ">"
+		}
+		return elem + "[]"
+	}
+	if t.IsMap() {
+		key, value := t.UnpackMap()
+		return "Record<" + model.tsTypeOf(key) + ", " + model.tsTypeOf(value) + ">"
+	}
+	if t.IsRef() {
+		// the target can be missing when it was removed by a profile
+		target := t.UnpackRef()
+		if model.TypeMap[string(target)] == nil {
+			return model.RefClass().Name + "<" + tsTyped + ">"
+		}
+		return model.RefClass().Name + "<" + model.tsClassTypeOf(target) + ">"
+	}
+	if t.IsUnion() {
+		alternatives := t.UnpackUnion()
+		types := make([]string, 0, len(alternatives))
+		for _, alt := range alternatives {
+			types = append(types, model.tsTypeOf(alt))
+		}
+		return strings.Join(types, " | ")
+	}
+	if t.IsPrimitiveOf(model) {
+		if tsType := model.Primitives.TargetOf(string(t), "ts"); tsType != "" {
+			return tsType
+		}
+		log.Println("WARNING: no TypeScript type defined for primitive:", t)
+		return "unknown"
+	}
+	if t.IsClassOf(model) {
+		return model.tsClassTypeOf(t)
+	}
+	if startsWithLower(string(t)) {
+		log.Println("WARNING: unknown primitive type:", t)
+		return "unknown"
+	}
+	return string(t)
+}
+
+// Returns the type of the given class type. For abstract classes, this is the
+// union of their concrete sub-classes, or `RootEntity` for the root entities.
+func (model *YamlModel) tsClassTypeOf(t YamlPropType) string {
+	yt := model.TypeMap[string(t)]
+	if yt == nil || !yt.IsClass() || !model.IsAbstract(yt.Class) {
+		return string(t)
+	}
+	if yt.Class.Name == "RootEntity" && model.tsHasRoots() {
+		return "RootEntity"
+	}
+	targets := model.pyRefTargetsOf(t)
+	if len(targets) == 0 {
+		return "never"
+	}
+	return strings.Join(targets, " | ")
+}
+
+// Returns the TSDoc comment of the given documentation and deprecation note
+// with the given indentation, or an empty string if both are empty.
+func tsDocOf(doc, deprecation, indent string) string {
+	doc = strings.ReplaceAll(strings.Join(strings.Fields(doc), " "), "*/", "*\\/")
+	if doc == "" && deprecation == "" {
+		return ""
+	}
+	if deprecation == "" && len(indent)+len(doc)+7 <= 80 {
+		return indent + "/** " + doc + " */"
+	}
+	lines := []string{indent + "/**"}
+	for _, line := range formatCommentLines(doc, indent) {
+		lines = append(lines, indent+" * "+line)
+	}
+	if deprecation != "" {
+		if doc != "" {
+			lines = append(lines, indent+" *")
+		}
+		for _, line := range formatCommentLines("@deprecated "+deprecation, indent) {
+			lines = append(lines, indent+" * "+line)
+		}
+	}
+	return strings.Join(append(lines, indent+" */"), "\n")
+}
+
+// Returns the key of a property in an interface: the name itself if it is a
+// valid identifier or the quoted name otherwise, e.g. `'@id'`.
+func tsKeyOf(name string) string {
+	if tsIdentifier.MatchString(name) {
+		return name
+	}
+	return tsStringOf(name)
+}
+
+// Returns the given string as single-quoted TypeScript string literal.
+func tsStringOf(s string) string {
+	s = strings.ReplaceAll(s, "\\", "\\\\")
+	s = strings.ReplaceAll(s, "'", "\\'")
+	s = strings.ReplaceAll(s, "\n", "\\n")
+	return "'" + s + "'"
+}
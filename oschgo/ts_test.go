@@ -0,0 +1,101 @@
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files in testdata")
+
+// Generates the TypeScript module of the test schema.
+func tsModuleOf(t *testing.T, enums bool) string {
+	t.Helper()
+	model, err := readTestModel(t, pyTestFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return GenTypeScript(model, enums)
+}
+
+// The module of the test schema is compared with `testdata/schema.ts`, which
+// can be updated with `go test -run TestTypeScript -update`.
+func TestTypeScript(t *testing.T) {
+	module := tsModuleOf(t, false)
+	golden := filepath.Join("testdata", "schema.ts")
+	if *updateGolden {
+		if err := ioutil.WriteFile(golden, []byte(module), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expected := readTestFile(t, golden)
+	if module != expected {
+		t.Errorf("the generated module differs from %s; run the test with "+
+			"-update if the change is intended:\n%s", golden, module)
+	}
+}
+
+func TestTypeScriptEnums(t *testing.T) {
+	module := tsModuleOf(t, true)
+	for _, line := range []string{
+		"export enum FlowType {",
+		"  PRODUCT_FLOW = 'PRODUCT_FLOW',",
+		"  return (Object.values(FlowType) as unknown[]).includes(x);",
+	} {
+		if !strings.Contains(module, line+"\n") {
+			t.Error("missing line in TypeScript module:", line)
+		}
+	}
+	if strings.Contains(module, "FLOW_TYPE_VALUES") {
+		t.Error("enumerations should not have a values array")
+	}
+}
+
+// Uses the generated types, so that `tsc` also checks that the references are
+// generic over the type of their target.
+const tsUsage = `import { Flow, Ref, UnitGroup, isRef, isRootEntity } from './schema';
+import { FlowType } from './schema_enums';
+
+const ref: Ref<Flow> = { '@type': 'Flow', '@id': 'steel', name: 'steel' };
+
+// @ts-expect-error the type of a reference must match its target
+const wrong: Ref<Flow> = { '@type': 'UnitGroup', '@id': 'mass', name: 'mass' };
+
+const group: UnitGroup = {
+  '@type': 'UnitGroup',
+  '@id': 'mass',
+  name: 'Units of mass',
+  refFlow: ref,
+  units: [{ '@type': 'Unit', '@id': 'kg', name: 'kg', conversionFactor: 1 }],
+};
+
+export function typeOf(x: unknown): string | undefined {
+  if (isRootEntity(x)) {
+    return x['@type'];
+  }
+  return isRef(x) ? x['@type'] : undefined;
+}
+
+export const values = [ref, wrong, group, FlowType.PRODUCT_FLOW];
+`
+
+// Type checks the generated modules with `tsc --strict` if it is installed.
+func TestTypeScriptCompiles(t *testing.T) {
+	tsc, err := exec.LookPath("tsc")
+	if err != nil {
+		t.Skip("tsc is not installed")
+	}
+	dir := t.TempDir()
+	writeFile(filepath.Join(dir, "schema.ts"), tsModuleOf(t, false))
+	writeFile(filepath.Join(dir, "schema_enums.ts"), tsModuleOf(t, true))
+	writeFile(filepath.Join(dir, "usage.ts"), tsUsage)
+	cmd := exec.Command(tsc, "--noEmit", "--strict", "--target", "es2017",
+		"schema.ts", "schema_enums.ts", "usage.ts")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("tsc --noEmit failed:\n%s", out)
+	}
+}
@@ -21,6 +21,37 @@ func toSnakeCase(identifier string) string {
 	return buff.String()
 }
 
+// Converts the given identifier to snake case like toSnakeCase but keeps
+// acronyms together, e.g. `DQSystem` becomes `dq_system`.
+func toSnakeName(identifier string) string {
+	runes := []rune(identifier)
+	var buff bytes.Buffer
+	for i, char := range runes {
+		if i > 0 && unicode.IsUpper(char) &&
+			(!unicode.IsUpper(runes[i-1]) ||
+				(i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			buff.WriteRune('_')
+		}
+		buff.WriteRune(unicode.ToLower(char))
+	}
+	return buff.String()
+}
+
+// Returns the English plural of the given noun in lower case, e.g.
+// `process` becomes `processes` and `currency` becomes `currencies`.
+func toPlural(noun string) string {
+	switch {
+	case strings.HasSuffix(noun, "y") && len(noun) > 1 &&
+		!strings.ContainsAny(noun[len(noun)-2:len(noun)-1], "aeiou"):
+		return noun[:len(noun)-1] + "ies"
+	case strings.HasSuffix(noun, "s"), strings.HasSuffix(noun, "x"),
+		strings.HasSuffix(noun, "ch"), strings.HasSuffix(noun, "sh"):
+		return noun + "es"
+	default:
+		return noun + "s"
+	}
+}
+
 // Formats the given comment to have a line length of max. 80 characters.
 func formatComment(comment string, indent string) string {
 	if strings.TrimSpace(comment) == "" {
@@ -65,6 +96,19 @@ func formatComment(comment string, indent string) string {
 	return text
 }
 
+// Formats the given comment like formatComment but returns the lines without
+// the indentation and comment markers, e.g. for docstrings or TSDoc comments
+// that start with a marker of the same length.
+func formatCommentLines(comment string, indent string) []string {
+	var lines []string
+	for _, line := range strings.Split(formatComment(comment, indent), "\n") {
+		if line = strings.TrimPrefix(line, indent+"//"); line != "" {
+			lines = append(lines, strings.TrimPrefix(line, " "))
+		}
+	}
+	return lines
+}
+
 func writeFile(file, content string) {
 	err := ioutil.WriteFile(file, []byte(content), os.ModePerm)
 	check(err, "failed to write file: "+file)
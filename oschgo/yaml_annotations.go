@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// YamlAnnotations contains the generator specific annotations of a schema
+// element. These are the attributes of the element that start with an `x-`
+// prefix, like `x-python-name`. As the map is inlined in the YAML elements,
+// it also collects unknown attributes which are reported by the schema check.
+type YamlAnnotations map[string]interface{}
+
+// The known annotations and the elements on which they can be used.
+var knownAnnotations = map[string]struct {
+	isBool   bool
+	elements string
+}{
+	// skip the element in the proto3 output
+	"x-proto-skip": {true, "class, enum, item, property"},
+	// the name of the element in the proto3 output
+	"x-proto-name": {false, "class, enum, item, property"},
+	// the class is a reference with the `@type` of the referenced entity
+	"x-ref": {true, "class"},
+	// the name of a property in the Python class
+	"x-python-name": {false, "property"},
+	// the field of a Python class that holds the `@type` of an instance
+	"x-python-type-field": {false, "class"},
+	// generate a `to_ref` method for a Python class
+	"x-python-to-ref": {true, "class"},
+	// the folder of a root entity in zip packages
+	"x-zip-folder": {false, "class"},
+}
+
+// String returns the value of the given annotation or an empty string if the
+// annotation is not defined.
+func (a YamlAnnotations) String(key string) string {
+	if v, ok := a[key]; ok && v != nil {
+		return fmt.Sprint(v)
+	}
+	return ""
+}
+
+// Bool returns true if the given annotation is set to `true`.
+func (a YamlAnnotations) Bool(key string) bool {
+	b, ok := a[key].(bool)
+	return ok && b
+}
+
+// Validate returns the problems of the annotations of the given element type
+// (`class`, `enum`, `item`, or `property`), like unknown attributes or values
+// of the wrong type, in a stable order.
+func (a YamlAnnotations) Validate(element string) []string {
+	keys := make([]string, 0, len(a))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var problems []string
+	for _, key := range keys {
+		if !strings.HasPrefix(key, "x-") {
+			problems = append(problems, "unknown attribute '"+key+"'")
+			continue
+		}
+		known, ok := knownAnnotations[key]
+		if !ok {
+			// other x- annotations are allowed, e.g. for external tools
+			continue
+		}
+		if !strings.Contains(known.elements, element) {
+			problems = append(problems, "annotation '"+key+
+				"' is not supported for the element type '"+element+"'")
+			continue
+		}
+		if _, isBool := a[key].(bool); isBool != known.isBool {
+			kind := "a string"
+			if known.isBool {
+				kind = "a boolean"
+			}
+			problems = append(problems, "the value of annotation '"+key+
+				"' must be "+kind)
+		}
+	}
+	return problems
+}
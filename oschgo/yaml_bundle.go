@@ -0,0 +1,336 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// BundleFormat is the version of the bundle format.
+const BundleFormat = "1"
+
+// YamlBundle is the fully resolved model in a single JSON or YAML file. The
+// types contain their own properties and items, with the extensions of
+// overlays listed separately in the overlays, and the resolved class
+// hierarchy with the flattened property lists. The hash is computed from the
+// YAML representation of the bundle without the hash, so it does not depend
+// on the format of the bundle file.
+type YamlBundle struct {
+	Format     string            `yaml:"format"`
+	Hash       string            `yaml:"hash,omitempty"`
+	Profile    string            `yaml:"profile,omitempty"`
+	Manifest   *YamlManifest     `yaml:"manifest"`
+	Primitives []*YamlPrimitive  `yaml:"primitives"`
+	Namespaces []*YamlNamespace  `yaml:"namespaces,omitempty"`
+	Overlays   []*YamlOverlay    `yaml:"overlays,omitempty"`
+	Types      []*YamlBundleType `yaml:"types"`
+}
+
+// YamlBundleType is a type of the bundle with the names of its namespace and
+// overlay and, for classes, the resolved hierarchy information.
+type YamlBundleType struct {
+	Namespace string           `yaml:"namespace,omitempty"`
+	Overlay   string           `yaml:"overlay,omitempty"`
+	Class     *YamlClass       `yaml:"class,omitempty"`
+	Enum      *YamlEnum        `yaml:"enum,omitempty"`
+	Resolved  *YamlBundleClass `yaml:"resolved,omitempty"`
+}
+
+// YamlBundleClass contains the resolved hierarchy information of a class. The
+// super classes are listed from the direct parent up to the top of the
+// hierarchy.
+type YamlBundleClass struct {
+	SuperClasses []string          `yaml:"superClasses,omitempty"`
+	SubClasses   []string          `yaml:"subClasses,omitempty"`
+	Root         bool              `yaml:"root"`
+	Abstract     bool              `yaml:"abstract"`
+	Properties   []*YamlBundleProp `yaml:"properties"`
+}
+
+// YamlBundleProp is a property in the flattened property list of a class with
+// the class and overlay in which it is declared.
+type YamlBundleProp struct {
+	Name     string `yaml:"name"`
+	Type     string `yaml:"type"`
+	Index    int    `yaml:"index"`
+	Required bool   `yaml:"required,omitempty"`
+	Class    string `yaml:"class"`
+	Overlay  string `yaml:"overlay,omitempty"`
+}
+
+// NewBundle creates the bundle of the given model.
+func NewBundle(model *YamlModel) (*YamlBundle, error) {
+	bundle := &YamlBundle{
+		Format:     BundleFormat,
+		Profile:    model.Profile,
+		Manifest:   model.Manifest,
+		Namespaces: model.Namespaces(),
+	}
+
+	names := make([]string, 0, len(model.Primitives))
+	for name := range model.Primitives {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		bundle.Primitives = append(bundle.Primitives, model.Primitives[name])
+	}
+
+	// the overlays without their types, as these are in the types of the bundle
+	for _, o := range model.Overlays {
+		overlay := *o
+		overlay.Types = nil
+		bundle.Overlays = append(bundle.Overlays, &overlay)
+	}
+
+	index := model.Index()
+	declaring := make(map[*YamlProp]*YamlClass)
+	model.EachClass(func(class *YamlClass) {
+		for _, prop := range class.Props {
+			declaring[prop] = class
+		}
+	})
+	for _, t := range model.Types {
+		bt := &YamlBundleType{
+			Namespace: t.NamespaceName(),
+			Overlay:   t.Origin,
+		}
+		bundle.Types = append(bundle.Types, bt)
+
+		// the own properties and items without the extensions of overlays
+		if t.IsEnum() {
+			enum := *t.Enum
+			enum.Items = nil
+			for _, item := range t.Enum.Items {
+				if item.Origin == "" || item.Origin == t.Origin {
+					enum.Items = append(enum.Items, item)
+				}
+			}
+			bt.Enum = &enum
+			continue
+		}
+		class := *t.Class
+		class.Props = nil
+		for _, prop := range t.Class.Props {
+			if prop.Origin == "" || prop.Origin == t.Origin {
+				class.Props = append(class.Props, prop)
+			}
+		}
+		bt.Class = &class
+
+		resolved := &YamlBundleClass{
+			Root:     index.IsRoot(t.Class),
+			Abstract: index.IsAbstract(t.Class),
+		}
+		for p := index.ParentOf(t.Class); p != nil; p = index.ParentOf(p) {
+			resolved.SuperClasses = append(resolved.SuperClasses, p.Name)
+		}
+		for _, sub := range index.SubClassesOf(t.Class) {
+			resolved.SubClasses = append(resolved.SubClasses, sub.Name)
+		}
+		for _, prop := range index.AllPropsOf(t.Class) {
+			bp := &YamlBundleProp{
+				Name:     prop.Name,
+				Type:     prop.Type,
+				Index:    prop.Index,
+				Required: prop.Required,
+				Overlay:  prop.Origin,
+			}
+			if c := declaring[prop]; c != nil {
+				bp.Class = c.Name
+			}
+			resolved.Properties = append(resolved.Properties, bp)
+		}
+		bt.Resolved = resolved
+	}
+
+	data, err := yaml.Marshal(bundle)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(data)
+	bundle.Hash = "sha256:" + hex.EncodeToString(hash[:])
+	return bundle, nil
+}
+
+// Encode returns the bundle in the given format, `json` or `yaml`.
+func (bundle *YamlBundle) Encode(format string) ([]byte, error) {
+	data, err := yaml.Marshal(bundle)
+	if err != nil || format == "yaml" {
+		return data, err
+	}
+	if format != "json" {
+		return nil, fmt.Errorf("unknown bundle format: %s", format)
+	}
+
+	// convert the YAML data into a JSON compatible structure; the order of
+	// the keys is kept as it is relevant for some fields, like the proto options
+	var raw yaml.MapSlice
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	var buff bytes.Buffer
+	encoder := json.NewEncoder(&buff)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(jsonValueOf(raw)); err != nil {
+		return nil, err
+	}
+	return buff.Bytes(), nil
+}
+
+// jsonObject is a JSON object with ordered keys.
+type jsonObject yaml.MapSlice
+
+func (obj jsonObject) MarshalJSON() ([]byte, error) {
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	for i, item := range obj {
+		if i > 0 {
+			buff.WriteByte(',')
+		}
+		key, err := json.Marshal(fmt.Sprint(item.Key))
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(item.Value)
+		if err != nil {
+			return nil, err
+		}
+		buff.Write(key)
+		buff.WriteByte(':')
+		buff.Write(value)
+	}
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// Converts the maps of a value that was parsed from YAML into JSON objects.
+func jsonValueOf(v interface{}) interface{} {
+	switch val := v.(type) {
+	case yaml.MapSlice:
+		obj := make(jsonObject, 0, len(val))
+		for _, item := range val {
+			obj = append(obj, yaml.MapItem{Key: item.Key, Value: jsonValueOf(item.Value)})
+		}
+		return obj
+	case []interface{}:
+		for i, value := range val {
+			val[i] = jsonValueOf(value)
+		}
+		return val
+	default:
+		return val
+	}
+}
+
+// IsBundleFile returns true if the given path is a JSON or YAML file that can
+// contain a bundle.
+func IsBundleFile(path string) bool {
+	if isDir(path) {
+		return false
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json", ".yaml", ".yml":
+		return true
+	default:
+		return false
+	}
+}
+
+// ReadBundle reads the model from the given bundle file. JSON is read as
+// YAML, so both formats are supported. An error is returned if the content
+// does not match the hash of the bundle.
+func ReadBundle(file string) (*YamlModel, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	bundle := &YamlBundle{Manifest: defaultManifest()}
+	if err := yaml.Unmarshal(data, bundle); err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	if bundle.Format != BundleFormat {
+		return nil, fmt.Errorf("%s: unsupported bundle format '%s'",
+			file, bundle.Format)
+	}
+
+	namespaces := make(map[string]*YamlNamespace)
+	for _, ns := range bundle.Namespaces {
+		namespaces[ns.Name] = ns
+	}
+	primitives := make(YamlPrimitives)
+	for _, p := range bundle.Primitives {
+		primitives[p.Name] = p
+	}
+
+	types := make([]*YamlType, 0, len(bundle.Types))
+	typeMap := make(map[string]*YamlType)
+	for _, bt := range bundle.Types {
+		t := &YamlType{
+			Class:  bt.Class,
+			Enum:   bt.Enum,
+			File:   file,
+			Origin: bt.Overlay,
+		}
+		if t.Class == nil && t.Enum == nil {
+			return nil, fmt.Errorf("%s: type without class or enum", file)
+		}
+		if bt.Namespace != "" {
+			if t.Namespace = namespaces[bt.Namespace]; t.Namespace == nil {
+				return nil, fmt.Errorf("%s: unknown namespace %s of type %s",
+					file, bt.Namespace, t.Name())
+			}
+		}
+		if typeMap[t.Name()] != nil {
+			return nil, fmt.Errorf("%s: duplicate type %s", file, t.Name())
+		}
+		types = append(types, t)
+		typeMap[t.Name()] = t
+	}
+
+	for _, overlay := range bundle.Overlays {
+		overlay.File = file
+		for _, t := range types {
+			if t.Origin == overlay.Name {
+				overlay.Types = append(overlay.Types, t)
+				overlay.Namespace = t.Namespace
+			}
+		}
+		if err := overlay.apply(typeMap); err != nil {
+			return nil, err
+		}
+	}
+
+	model := &YamlModel{
+		Types:      types,
+		TypeMap:    typeMap,
+		Manifest:   bundle.Manifest,
+		Primitives: primitives,
+		Overlays:   bundle.Overlays,
+		Profile:    bundle.Profile,
+	}
+	if err := model.finish(); err != nil {
+		return nil, err
+	}
+
+	if bundle.Hash != "" {
+		check, err := NewBundle(model)
+		if err != nil {
+			return nil, err
+		}
+		if check.Hash != bundle.Hash {
+			return nil, fmt.Errorf("%s: the content does not match the hash "+
+				"of the bundle", file)
+		}
+	}
+	return model, nil
+}
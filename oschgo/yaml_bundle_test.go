@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// A schema with a namespace and an overlay that extends a class of the base
+// schema and adds a type in the namespace.
+var bundleTestFiles = map[string]string{
+	ManifestFile: `name: Test Schema
+version: 1.0.0
+`,
+	"Flow.yaml": `class:
+  name: Flow
+  doc: A flow is an input or output of a process.
+  properties:
+  - name: name
+    type: string
+    index: 1
+  - name: property
+    type: FlowProperty
+    index: 2
+`,
+	"ext/" + NamespaceFile: "name: ext\n",
+	"ext/FlowProperty.yaml": `class:
+  name: FlowProperty
+  properties:
+  - name: name
+    type: string
+    index: 1
+`,
+	"ext/Acme.yaml": `overlay:
+  name: acme
+  minIndex: 100
+  maxIndex: 199
+  extends:
+  - class: Flow
+    properties:
+    - name: acmeId
+      type: string
+      index: 100
+  types:
+  - class:
+      name: AcmeReviewer
+      properties:
+      - name: email
+        type: string
+        index: 101
+`,
+}
+
+// Exports the bundle of the given model in the given format into a file of a
+// temporary folder and returns the content and the path of that file.
+func writeTestBundle(t *testing.T, model *YamlModel, format string) ([]byte, string) {
+	t.Helper()
+	bundle, err := NewBundle(model)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := bundle.Encode(format)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(t.TempDir(), "bundle."+format)
+	writeFile(file, string(data))
+	return data, file
+}
+
+func TestBundleRoundTrip(t *testing.T) {
+	model, err := readTestModel(t, bundleTestFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, format := range []string{"json", "yaml"} {
+		data, file := writeTestBundle(t, model, format)
+		read, err := ReadBundle(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		again, _ := writeTestBundle(t, read, format)
+		if !bytes.Equal(data, again) {
+			t.Errorf("the %s bundle changed in the round trip:\n%s\n---\n%s",
+				format, data, again)
+		}
+	}
+}
+
+func TestBundleHashCheck(t *testing.T) {
+	model, err := readTestModel(t, bundleTestFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, file := writeTestBundle(t, model, "yaml")
+	tampered := strings.Replace(string(data), "A flow is", "A flaw is", 1)
+	if tampered == string(data) {
+		t.Fatal("the bundle does not contain the documentation of Flow")
+	}
+	writeFile(file, tampered)
+	_, err = ReadBundle(file)
+	if err == nil || !strings.Contains(err.Error(), "hash") {
+		t.Error("expected a hash error for a tampered bundle, got", err)
+	}
+}
+
+func TestBundleOverlaysAndNamespaces(t *testing.T) {
+	model, err := readTestModel(t, bundleTestFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, file := writeTestBundle(t, model, "json")
+	read, err := ReadBundle(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(read.Overlays) != 1 || read.Overlays[0].Name != "acme" ||
+		read.Overlays[0].MinIndex != 100 || read.Overlays[0].MaxIndex != 199 {
+		t.Fatal("the overlay acme was not restored")
+	}
+	reviewer := read.TypeMap["AcmeReviewer"]
+	if reviewer == nil || reviewer.Origin != "acme" {
+		t.Error("the type of the overlay was not restored")
+	}
+	var acmeId *YamlProp
+	for _, prop := range read.TypeMap["Flow"].Class.Props {
+		if prop.Name == "acmeId" {
+			acmeId = prop
+		}
+	}
+	if acmeId == nil || acmeId.Origin != "acme" || acmeId.Index != 100 {
+		t.Error("the extension of Flow by the overlay was not restored")
+	}
+
+	for _, name := range []string{"FlowProperty", "AcmeReviewer"} {
+		if ns := read.TypeMap[name].NamespaceName(); ns != "ext" {
+			t.Errorf("expected %s in namespace ext, got '%s'", name, ns)
+		}
+	}
+	if ns := read.TypeMap["Flow"].NamespaceName(); ns != "" {
+		t.Error("expected Flow in the default namespace, got", ns)
+	}
+}
@@ -0,0 +1,246 @@
+package main
+
+import (
+	"sort"
+)
+
+// YamlIndex is the resolved form of a model. It contains the class hierarchy,
+// the flattened property lists, and the dependencies and references between
+// the types. The index is built once in the resolution phase after the model
+// was read; the slices returned by its query methods are shared and must not
+// be modified.
+type YamlIndex struct {
+	parents      map[*YamlClass]*YamlClass
+	mixins       map[*YamlClass][]*YamlClass
+	subClasses   map[*YamlClass][]*YamlClass
+	allProps     map[*YamlClass][]*YamlProp
+	dependencies map[*YamlClass][]*YamlClass
+	references   map[string][]YamlPropRef
+	isRoot       map[*YamlClass]bool
+	isAbstract   map[*YamlClass]bool
+	isMixin      map[*YamlClass]bool
+	isUnion      map[*YamlClass]bool
+	refClass     *YamlClass
+}
+
+// YamlPropRef is a property of a class that references some type.
+type YamlPropRef struct {
+	Class *YamlClass
+	Prop  *YamlProp
+}
+
+// Resolve builds the index of the model. It has to be called again when the
+// types of the model are changed.
+func (model *YamlModel) Resolve() *YamlIndex {
+	model.index = newYamlIndex(model)
+	return model.index
+}
+
+// Index returns the index of the model. It is built on the first call if the
+// model was not resolved yet.
+func (model *YamlModel) Index() *YamlIndex {
+	if model.index == nil {
+		return model.Resolve()
+	}
+	return model.index
+}
+
+func newYamlIndex(model *YamlModel) *YamlIndex {
+	index := &YamlIndex{
+		parents:      make(map[*YamlClass]*YamlClass),
+		mixins:       make(map[*YamlClass][]*YamlClass),
+		subClasses:   make(map[*YamlClass][]*YamlClass),
+		allProps:     make(map[*YamlClass][]*YamlProp),
+		dependencies: make(map[*YamlClass][]*YamlClass),
+		references:   make(map[string][]YamlPropRef),
+		isRoot:       make(map[*YamlClass]bool),
+		isAbstract:   make(map[*YamlClass]bool),
+		isMixin:      make(map[*YamlClass]bool),
+		isUnion:      make(map[*YamlClass]bool),
+	}
+	classOf := func(name string) *YamlClass {
+		if t := model.TypeMap[name]; t != nil && t.IsClass() {
+			return t.Class
+		}
+		return nil
+	}
+
+	// direct relations and references
+	var classes []*YamlClass
+	model.EachClass(func(class *YamlClass) {
+		classes = append(classes, class)
+		if class.IsRef() && index.refClass == nil {
+			index.refClass = class
+		}
+		if class.Abstract {
+			index.isAbstract[class] = true
+		}
+		if parent := classOf(class.SuperClass); parent != nil {
+			index.parents[class] = parent
+			index.subClasses[parent] = append(index.subClasses[parent], class)
+			index.isAbstract[parent] = true
+		}
+		for _, name := range class.Mixins {
+			if mixin := classOf(name); mixin != nil {
+				index.mixins[class] = append(index.mixins[class], mixin)
+				index.isMixin[mixin] = true
+			}
+		}
+		for _, prop := range class.Props {
+			propType := prop.PropType()
+			if propType.IsUnion() {
+				for _, alt := range propType.UnpackUnion() {
+					if member := classOf(string(alt)); member != nil {
+						index.isUnion[member] = true
+					}
+				}
+			}
+			for _, name := range typeNamesOf(propType, "") {
+				index.references[name] = append(index.references[name],
+					YamlPropRef{Class: class, Prop: prop})
+			}
+		}
+	})
+
+	// flattened properties, root flags, and dependencies
+	refName := ""
+	if index.refClass != nil {
+		refName = index.refClass.Name
+	}
+	for _, class := range classes {
+		index.allProps[class] = index.flattenProps(class)
+		for p := index.parents[class]; p != nil; p = index.parents[p] {
+			if p.Name == "RootEntity" {
+				index.isRoot[class] = true
+				break
+			}
+		}
+		seen := make(map[*YamlClass]bool)
+		for _, prop := range index.allProps[class] {
+			for _, name := range typeNamesOf(prop.PropType(), refName) {
+				dep := classOf(name)
+				if dep == nil || dep == class || seen[dep] {
+					continue
+				}
+				seen[dep] = true
+				index.dependencies[class] = append(index.dependencies[class], dep)
+			}
+		}
+	}
+	return index
+}
+
+// Returns the properties of the given class and its parents and mixins,
+// sorted by name.
+func (index *YamlIndex) flattenProps(class *YamlClass) []*YamlProp {
+	props := make([]*YamlProp, 0, len(class.Props)+1)
+	visited := make(map[*YamlClass]bool)
+	var addMixins func(c *YamlClass)
+	addMixins = func(c *YamlClass) {
+		for _, mixin := range index.mixins[c] {
+			if visited[mixin] {
+				continue
+			}
+			visited[mixin] = true
+			props = append(props, mixin.Props...)
+			addMixins(mixin)
+		}
+	}
+	for c := class; c != nil; c = index.parents[c] {
+		props = append(props, c.Props...)
+		addMixins(c)
+	}
+	sort.Sort(YamlPropsByName(props))
+	return props
+}
+
+// Returns the names of the types that are used in the given property type. A
+// reference is a usage of the reference class with the given name or, if the
+// name is empty, a usage of the target of the reference.
+func typeNamesOf(t YamlPropType, refClass string) []string {
+	switch {
+	case t.IsList():
+		return typeNamesOf(t.UnpackList(), refClass)
+	case t.IsRef():
+		if refClass == "" {
+			return typeNamesOf(t.UnpackRef(), refClass)
+		}
+		return []string{refClass}
+	case t.IsMap():
+		_, value := t.UnpackMap()
+		return typeNamesOf(value, refClass)
+	case t.IsUnion():
+		var names []string
+		for _, alt := range t.UnpackUnion() {
+			names = append(names, typeNamesOf(alt, refClass)...)
+		}
+		return names
+	default:
+		return []string{string(t)}
+	}
+}
+
+// ParentOf returns the super class of the given class or nil if it has no
+// super class.
+func (index *YamlIndex) ParentOf(class *YamlClass) *YamlClass {
+	return index.parents[class]
+}
+
+// MixinsOf returns the mixins that are directly included in the given class.
+func (index *YamlIndex) MixinsOf(class *YamlClass) []*YamlClass {
+	return index.mixins[class]
+}
+
+// SubClassesOf returns the direct sub-classes of the given class in the order
+// of their names.
+func (index *YamlIndex) SubClassesOf(class *YamlClass) []*YamlClass {
+	return index.subClasses[class]
+}
+
+// AllPropsOf returns the properties of the given class including the
+// properties of its parent classes and their mixins, sorted by name.
+func (index *YamlIndex) AllPropsOf(class *YamlClass) []*YamlProp {
+	return index.allProps[class]
+}
+
+// DependenciesOf returns the classes that are used in the types of the
+// properties of the given class, including its inherited properties. A
+// reference is a dependency on the reference class and not on its target.
+func (index *YamlIndex) DependenciesOf(class *YamlClass) []*YamlClass {
+	return index.dependencies[class]
+}
+
+// ReferencesTo returns the properties that use the type with the given name,
+// directly or in a list, map, union, or reference type.
+func (index *YamlIndex) ReferencesTo(name string) []YamlPropRef {
+	return index.references[name]
+}
+
+// RefClass returns the reference class of the model, which is the class with
+// the `x-ref` annotation, or nil if there is no such class. The `Ref[T]` types
+// of the properties are instances of this class.
+func (index *YamlIndex) RefClass() *YamlClass {
+	return index.refClass
+}
+
+// IsRoot returns true if `RootEntity` is a parent class of the given class.
+func (index *YamlIndex) IsRoot(class *YamlClass) bool {
+	return index.isRoot[class]
+}
+
+// IsAbstract returns true if the given class is marked as abstract or if it
+// is the super class of some other class.
+func (index *YamlIndex) IsAbstract(class *YamlClass) bool {
+	return index.isAbstract[class]
+}
+
+// IsMixin returns true if the given class is used as mixin in some class.
+func (index *YamlIndex) IsMixin(class *YamlClass) bool {
+	return index.isMixin[class]
+}
+
+// IsUnionMember returns true if the given class is an alternative of a union
+// type of some property.
+func (index *YamlIndex) IsUnionMember(class *YamlClass) bool {
+	return index.isUnion[class]
+}
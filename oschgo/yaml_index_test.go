@@ -0,0 +1,201 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+)
+
+// Creates a synthetic model with the given number of types. Every fourth
+// class is a root entity and the super class of the next three classes. The
+// classes have properties of primitive, enumeration, class, list, and
+// reference types; every fifth class includes a mixin.
+func syntheticModel(n int) *YamlModel {
+	model := &YamlModel{
+		TypeMap:    make(map[string]*YamlType),
+		Manifest:   defaultManifest(),
+		Primitives: make(YamlPrimitives),
+	}
+	add := func(t *YamlType) {
+		model.Types = append(model.Types, t)
+		model.TypeMap[t.Name()] = t
+	}
+	class := func(name, super string, props ...*YamlProp) *YamlClass {
+		c := &YamlClass{Name: name, SuperClass: super, Props: props}
+		add(&YamlType{Class: c})
+		return c
+	}
+	prop := func(name, t string) *YamlProp {
+		return &YamlProp{Name: name, Type: t}
+	}
+
+	class("Entity", "", prop("@type", "string"))
+	class("RefEntity", "Entity", prop("@id", "string"), prop("name", "string"))
+	class("RootEntity", "RefEntity", prop("version", "string"))
+	ref := class("Ref", "RefEntity", prop("category", "string"))
+	ref.Annotations = YamlAnnotations{"x-ref": true}
+	tagged := class("Tagged", "", prop("tags", "List[string]"))
+	tagged.Abstract = true
+
+	enums := n / 40
+	for i := 0; i < enums; i++ {
+		add(&YamlType{Enum: &YamlEnum{
+			Name:  fmt.Sprintf("E%04d", i),
+			Items: []*YamlEnumItem{{Name: "A"}, {Name: "B"}},
+		}})
+	}
+
+	for i := 0; len(model.Types) < n; i++ {
+		super := "RootEntity"
+		if i%4 != 0 {
+			super = fmt.Sprintf("C%04d", i-i%4)
+		}
+		props := []*YamlProp{
+			prop(fmt.Sprintf("name%d", i%7), "string"),
+			prop(fmt.Sprintf("kind%d", i%5), fmt.Sprintf("E%04d", i%enums)),
+		}
+		if i > 0 {
+			props = append(props,
+				prop(fmt.Sprintf("part%d", i%3), fmt.Sprintf("C%04d", (i*7)%i)),
+				prop(fmt.Sprintf("parts%d", i%3), fmt.Sprintf("List[C%04d]", (i*13)%i)),
+				prop(fmt.Sprintf("ref%d", i%3), fmt.Sprintf("Ref[C%04d]", (i*17)%i)))
+		}
+		c := class(fmt.Sprintf("C%04d", i), super, props...)
+		if i%5 == 0 {
+			c.Mixins = []string{"Tagged"}
+		}
+	}
+	sort.SliceStable(model.Types, func(i, j int) bool {
+		return model.Types[i].Name() < model.Types[j].Name()
+	})
+	return model
+}
+
+// The query implementations that scan the model on every call; these are the
+// baseline of the benchmarks.
+
+func scanParentOf(model *YamlModel, class *YamlClass) *YamlClass {
+	parent := model.TypeMap[class.SuperClass]
+	if parent == nil || parent.IsEnum() {
+		return nil
+	}
+	return parent.Class
+}
+
+func scanIsAbstract(model *YamlModel, class *YamlClass) bool {
+	if class.Abstract {
+		return true
+	}
+	for _, t := range model.Types {
+		if t.IsClass() && t.Class.SuperClass == class.Name {
+			return true
+		}
+	}
+	return false
+}
+
+func scanIsRoot(model *YamlModel, class *YamlClass) bool {
+	for c := scanParentOf(model, class); c != nil; c = scanParentOf(model, c) {
+		if c.Name == "RootEntity" {
+			return true
+		}
+	}
+	return false
+}
+
+func scanAllPropsOf(model *YamlModel, class *YamlClass) []*YamlProp {
+	props := make([]*YamlProp, 0, len(class.Props)+1)
+	visited := make(map[*YamlClass]bool)
+	var mixinProps func(c *YamlClass)
+	mixinProps = func(c *YamlClass) {
+		for _, name := range c.Mixins {
+			t := model.TypeMap[name]
+			if t == nil || !t.IsClass() || visited[t.Class] {
+				continue
+			}
+			visited[t.Class] = true
+			props = append(props, t.Class.Props...)
+			mixinProps(t.Class)
+		}
+	}
+	for c := class; c != nil; c = scanParentOf(model, c) {
+		props = append(props, c.Props...)
+		mixinProps(c)
+	}
+	sort.Sort(YamlPropsByName(props))
+	return props
+}
+
+func scanDependenciesOf(model *YamlModel, class *YamlClass) []*YamlClass {
+	var deps []*YamlClass
+	seen := make(map[string]bool)
+	for _, prop := range scanAllPropsOf(model, class) {
+		for _, name := range typeNamesOf(prop.PropType(), "Ref") {
+			t := model.TypeMap[name]
+			if t == nil || !t.IsClass() || name == class.Name || seen[name] {
+				continue
+			}
+			seen[name] = true
+			deps = append(deps, t.Class)
+		}
+	}
+	return deps
+}
+
+func TestIndexMatchesScan(t *testing.T) {
+	model := syntheticModel(200)
+	index := model.Resolve()
+	model.EachClass(func(class *YamlClass) {
+		if index.IsAbstract(class) != scanIsAbstract(model, class) {
+			t.Errorf("IsAbstract(%s) differs", class.Name)
+		}
+		if index.IsRoot(class) != scanIsRoot(model, class) {
+			t.Errorf("IsRoot(%s) differs", class.Name)
+		}
+		if fmt.Sprint(index.AllPropsOf(class)) !=
+			fmt.Sprint(scanAllPropsOf(model, class)) {
+			t.Errorf("AllPropsOf(%s) differs", class.Name)
+		}
+		if fmt.Sprint(index.DependenciesOf(class)) !=
+			fmt.Sprint(scanDependenciesOf(model, class)) {
+			t.Errorf("DependenciesOf(%s) differs", class.Name)
+		}
+	})
+}
+
+// Runs the hierarchy queries of the generators for every class.
+func BenchmarkQueriesScan(b *testing.B) {
+	model := syntheticModel(2000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		model.EachClass(func(class *YamlClass) {
+			scanIsAbstract(model, class)
+			scanIsRoot(model, class)
+			scanAllPropsOf(model, class)
+			scanDependenciesOf(model, class)
+		})
+	}
+}
+
+func BenchmarkQueriesIndexed(b *testing.B) {
+	model := syntheticModel(2000)
+	index := model.Resolve()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		model.EachClass(func(class *YamlClass) {
+			index.IsAbstract(class)
+			index.IsRoot(class)
+			index.AllPropsOf(class)
+			index.DependenciesOf(class)
+		})
+	}
+}
+
+// Builds the index with the hierarchy, properties, and dependencies.
+func BenchmarkResolve(b *testing.B) {
+	model := syntheticModel(2000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		model.Resolve()
+	}
+}
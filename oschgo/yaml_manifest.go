@@ -0,0 +1,99 @@
+package main
+
+import (
+	"errors"
+	"io/fs"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ManifestFile is the name of the optional manifest file in the YAML directory.
+const ManifestFile = "schema.yaml"
+
+// YamlManifest contains the schema-wide settings of the manifest file. Fields
+// that are not defined in the manifest are initialized with the defaults from
+// defaultManifest.
+type YamlManifest struct {
+	Name       string                  `yaml:"name"`
+	Version    string                  `yaml:"version"`
+	License    string                  `yaml:"license"`
+	BaseUrl    string                  `yaml:"baseUrl"`
+	Generators YamlGeneratorConfig     `yaml:"generators"`
+	Profiles   map[string]*YamlProfile `yaml:"profiles"`
+}
+
+type YamlGeneratorConfig struct {
+	Proto  YamlProtoConfig  `yaml:"proto"`
+	Python YamlPythonConfig `yaml:"python"`
+	MdBook YamlMdBookConfig `yaml:"mdbook"`
+}
+
+type YamlProtoConfig struct {
+	Package string        `yaml:"package"`
+	Options yaml.MapSlice `yaml:"options"`
+}
+
+type YamlPythonConfig struct {
+	Package string `yaml:"package"`
+
+	// parse `date` and `dateTime` values into `datetime` objects
+	ParseDates bool `yaml:"parseDates,omitempty"`
+
+	// do not write empty lists in `to_dict`
+	OmitEmptyLists bool `yaml:"omitEmptyLists,omitempty"`
+
+	// generate required properties as non-optional, keyword-only fields, which
+	// needs Python 3.10 or later
+	RequiredFields bool `yaml:"requiredFields,omitempty"`
+}
+
+type YamlMdBookConfig struct {
+	Title string `yaml:"title"`
+}
+
+func defaultManifest() *YamlManifest {
+	return &YamlManifest{
+		Name:    "openLCA Schema",
+		BaseUrl: "http://greendelta.github.io/olca-schema",
+		Generators: YamlGeneratorConfig{
+			Proto: YamlProtoConfig{
+				Package: "protolca",
+				Options: yaml.MapSlice{
+					{Key: "csharp_namespace", Value: "ProtoLCA"},
+					{Key: "go_package", Value: ".;protolca"},
+					{Key: "java_package", Value: "org.openlca.proto"},
+					{Key: "java_outer_classname", Value: "Proto"},
+					{Key: "java_multiple_files", Value: true},
+				},
+			},
+			Python: YamlPythonConfig{
+				Package: "olca_schema",
+			},
+		},
+	}
+}
+
+// ReadManifest reads the manifest file from the root of the given YAML file
+// system. If there is no such file, the default manifest is returned.
+func ReadManifest(root fs.FS) (*YamlManifest, error) {
+	manifest := defaultManifest()
+	data, err := fs.ReadFile(root, ManifestFile)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return manifest, nil
+		}
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// Title returns the name of the schema together with its version, if defined.
+func (m *YamlManifest) Title() string {
+	if m.Version == "" {
+		return m.Name
+	}
+	return m.Name + " " + m.Version
+}
@@ -1,9 +1,10 @@
 package main
 
 import (
-	"io/ioutil"
+	"fmt"
+	"io/fs"
 	"log"
-	"path/filepath"
+	"path"
 	"sort"
 	"strings"
 
@@ -11,8 +12,15 @@ import (
 )
 
 type YamlType struct {
-	Class *YamlClass `yaml:"class"`
-	Enum  *YamlEnum  `yaml:"enum"`
+	Class   *YamlClass   `yaml:"class"`
+	Enum    *YamlEnum    `yaml:"enum"`
+	Overlay *YamlOverlay `yaml:"overlay"`
+
+	// the file and namespace from which the type was read and the name of the
+	// overlay if the type was defined in an overlay
+	File      string         `yaml:"-"`
+	Namespace *YamlNamespace `yaml:"-"`
+	Origin    string         `yaml:"-"`
 }
 
 func (yt *YamlType) IsClass() bool {
@@ -44,27 +52,73 @@ func (yt *YamlType) Name() string {
 }
 
 type YamlClass struct {
-	Name       string      `yaml:"name"`
-	SuperClass string      `yaml:"superClass"`
-	Doc        string      `yaml:"doc"`
-	Props      []*YamlProp `yaml:"properties"`
+	Name           string          `yaml:"name"`
+	SuperClass     string          `yaml:"superClass,omitempty"`
+	Abstract       bool            `yaml:"abstract,omitempty"`
+	Mixins         []string        `yaml:"mixins,omitempty"`
+	Doc            string          `yaml:"doc,omitempty"`
+	Props          []*YamlProp     `yaml:"properties,omitempty"`
+	Annotations    YamlAnnotations `yaml:",inline"`
+	YamlVersioning `yaml:",inline"`
 }
 
 type YamlEnum struct {
-	Name  string          `yaml:"name"`
-	Doc   string          `yaml:"doc"`
-	Items []*YamlEnumItem `yaml:"items"`
+	Name           string          `yaml:"name"`
+	Doc            string          `yaml:"doc,omitempty"`
+	Items          []*YamlEnumItem `yaml:"items,omitempty"`
+	Annotations    YamlAnnotations `yaml:",inline"`
+	YamlVersioning `yaml:",inline"`
 }
 
 type YamlEnumItem struct {
-	Name  string `yaml:"name"`
-	Doc   string `yaml:"doc"`
-	Index int    `yaml:"index"`
+	Name           string          `yaml:"name"`
+	Doc            string          `yaml:"doc,omitempty"`
+	Index          int             `yaml:"index,omitempty"`
+	Annotations    YamlAnnotations `yaml:",inline"`
+	YamlVersioning `yaml:",inline"`
+
+	// the name of the overlay that added the item
+	Origin string `yaml:"-"`
+}
+
+// YamlVersioning contains the versioning metadata of a schema element: the
+// schema version since when it is available and, if it is deprecated, a
+// deprecation message (or just `true`) and an optional replacement.
+type YamlVersioning struct {
+	Since      string `yaml:"since,omitempty"`
+	Deprecated string `yaml:"deprecated,omitempty"`
+	ReplacedBy string `yaml:"replacedBy,omitempty"`
+}
+
+func (v *YamlVersioning) IsDeprecated() bool {
+	return v.Deprecated != "" && v.Deprecated != "false"
+}
+
+// DeprecationNote returns a note like `X is deprecated: <message>; use Y
+// instead.` for the element with the given name or an empty string if the
+// element is not deprecated.
+func (v *YamlVersioning) DeprecationNote(name string) string {
+	if !v.IsDeprecated() {
+		return ""
+	}
+	note := name + " is deprecated"
+	if v.Deprecated != "true" {
+		note += ": " + strings.TrimSuffix(strings.TrimSpace(v.Deprecated), ".")
+	}
+	if v.ReplacedBy != "" {
+		note += "; use " + v.ReplacedBy + " instead"
+	}
+	return note + "."
 }
 
 type YamlModel struct {
-	Types   []*YamlType
-	TypeMap map[string]*YamlType
+	Types      []*YamlType
+	TypeMap    map[string]*YamlType
+	Manifest   *YamlManifest
+	Primitives YamlPrimitives
+	Overlays   []*YamlOverlay
+	Profile    string
+	index      *YamlIndex
 }
 
 func (model *YamlModel) EachEnum(consumer func(enum *YamlEnum)) {
@@ -86,104 +140,542 @@ func (model *YamlModel) EachClass(consumer func(enum *YamlClass)) {
 }
 
 func (model *YamlModel) ParentOf(class *YamlClass) *YamlClass {
-	if class == nil {
-		return nil
-	}
-	parentName := class.SuperClass
-	if parentName == "" {
-		return nil
+	return model.Index().ParentOf(class)
+}
+
+// MixinsOf returns the mixins that are directly included in the given class.
+// Mixins are abstract classes that define reusable groups of properties.
+func (model *YamlModel) MixinsOf(class *YamlClass) []*YamlClass {
+	return model.Index().MixinsOf(class)
+}
+
+// IsMixin returns true when the given class is used as mixin in some class.
+func (model *YamlModel) IsMixin(class *YamlClass) bool {
+	return model.Index().IsMixin(class)
+}
+
+// IsUnionMember returns true when the given class is an alternative of a union
+// type of some property.
+func (model *YamlModel) IsUnionMember(class *YamlClass) bool {
+	return model.Index().IsUnionMember(class)
+}
+
+// IsAbstract returns true when the given class is an abstract class. This is
+// the case when it is explicitly marked as abstract or when it is a super
+// class of some other class; only the leafs of the class hierarchy are
+// non-abstract classes.
+func (model *YamlModel) IsAbstract(class *YamlClass) bool {
+	return model.Index().IsAbstract(class)
+}
+
+func (model *YamlModel) IsEmpty() bool {
+	return len(model.Types) == 0
+}
+
+// ReadYamlModel reads the schema model from the given input folders. See
+// ReadYamlSources for the details.
+func ReadYamlModel(dirs ...string) (*YamlModel, error) {
+	if len(dirs) == 0 {
+		dirs = []string{"."}
 	}
-	parent := model.TypeMap[parentName]
-	if parent == nil || parent.IsEnum() {
-		return nil
+	sources := make([]*YamlSource, 0, len(dirs))
+	for _, dir := range dirs {
+		sources = append(sources, DirSource(dir))
 	}
-	return parent.Class
+	return ReadYamlSources(sources...)
 }
 
-// IsAbstract returns true when the given class is an abstract super class.
-// Only the leafs of the class hierarchy are non-abstract classes.
-func (model *YamlModel) IsAbstract(class *YamlClass) bool {
-	for _, t := range model.Types {
-		if t.IsClass() {
-			if t.Class.SuperClass == class.Name {
-				return true
+// ReadYamlSources reads the schema model from the given input roots. The
+// types are read recursively from the folders of each root. The manifest is
+// read from the first root and the primitive types are collected from all
+// roots. Type names must be unique over all roots. Overlays are applied after
+// all roots are read.
+func ReadYamlSources(sources ...*YamlSource) (*YamlModel, error) {
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("no input roots")
+	}
+
+	types := make([]*YamlType, 0)
+	typeMap := make(map[string]*YamlType)
+	var overlays []*YamlOverlay
+	add := func(typeDef *YamlType) error {
+		name := typeDef.Name()
+		if other := typeMap[name]; other != nil {
+			return fmt.Errorf("duplicate type %s in %s and %s",
+				name, other.File, typeDef.File)
+		}
+		typeMap[name] = typeDef
+		types = append(types, typeDef)
+		return nil
+	}
+	for _, source := range sources {
+		dirTypes, err := readYamlTypes(source, ".", nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, typeDef := range dirTypes {
+			if typeDef.Overlay == nil {
+				if err := add(typeDef); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			overlay := typeDef.Overlay
+			if overlay.Name == "" {
+				return nil, fmt.Errorf("%s: overlay without name", typeDef.File)
+			}
+			overlay.File = typeDef.File
+			overlay.Namespace = typeDef.Namespace
+			overlays = append(overlays, overlay)
+			for _, overlayType := range overlay.types() {
+				if err := add(overlayType); err != nil {
+					return nil, err
+				}
 			}
 		}
 	}
-	return false
+	for _, overlay := range overlays {
+		if err := overlay.apply(typeMap); err != nil {
+			return nil, err
+		}
+	}
+	sort.SliceStable(types, func(i, j int) bool {
+		return types[i].Name() < types[j].Name()
+	})
+	log.Println("Collected", len(types), "YAML types")
+
+	manifest, err := ReadManifest(sources[0].FS)
+	if err != nil {
+		return nil, err
+	}
+
+	roots := make([]fs.FS, 0, len(sources))
+	for _, source := range sources {
+		roots = append(roots, source.FS)
+	}
+	primitives, err := ReadPrimitives(roots...)
+	if err != nil {
+		return nil, err
+	}
+
+	model := YamlModel{
+		Types:      types,
+		TypeMap:    typeMap,
+		Manifest:   manifest,
+		Primitives: primitives,
+		Overlays:   overlays,
+	}
+	if err := model.finish(); err != nil {
+		return nil, err
+	}
+	return &model, nil
 }
 
-func (model *YamlModel) IsEmpty() bool {
-	return len(model.Types) == 0
+// Validates the property types and default values of a model that was read
+// and builds its index.
+func (model *YamlModel) finish() error {
+	if err := model.validatePropTypes(); err != nil {
+		return err
+	}
+	if err := model.validateMixins(); err != nil {
+		return err
+	}
+	if err := model.validateDefaults(); err != nil {
+		return err
+	}
+	model.Resolve()
+	return nil
 }
 
-func ReadYamlModel(dir string) (*YamlModel, error) {
+// Reads the types from the given folder and its sub-folders. The types get the
+// namespace of the folder if it has a namespace file, or the namespace of the
+// parent folder otherwise. The manifest and primitive types are only read from
+// the root folder.
+func readYamlTypes(source *YamlSource, dir string, ns *YamlNamespace) ([]*YamlType, error) {
+	if dirNs, err := readNamespace(source, dir); err != nil {
+		return nil, err
+	} else if dirNs != nil {
+		ns = dirNs
+	}
 
-	files, err := ioutil.ReadDir(dir)
+	files, err := fs.ReadDir(source.FS, dir)
 	if err != nil {
 		return nil, err
 	}
 	types := make([]*YamlType, 0)
 	for _, file := range files {
 		name := file.Name()
-		if !strings.HasSuffix(name, ".yaml") {
+		filePath := path.Join(dir, name)
+		if file.IsDir() {
+			subTypes, err := readYamlTypes(source, filePath, ns)
+			if err != nil {
+				return nil, err
+			}
+			types = append(types, subTypes...)
+			continue
+		}
+		if !strings.HasSuffix(name, ".yaml") || name == NamespaceFile {
+			continue
+		}
+		if dir == "." && (name == ManifestFile || name == PrimitivesFile) {
 			continue
 		}
 
-		log.Println("Parse YAML file", name)
-		path := filepath.Join(dir, name)
-		data, err := ioutil.ReadFile(path)
+		file := source.PathOf(filePath)
+		log.Println("Parse YAML file", file)
+		data, err := fs.ReadFile(source.FS, filePath)
 		if err != nil {
 			return nil, err
 		}
 		typeDef := &YamlType{}
 		if err := yaml.Unmarshal(data, typeDef); err != nil {
-			return nil, err
+			return nil, fmt.Errorf("%s: %w", file, err)
 		}
-
+		if typeDef.Class == nil && typeDef.Enum == nil && typeDef.Overlay == nil {
+			return nil, fmt.Errorf("%s: no class, enum, or overlay definition", file)
+		}
+		typeDef.File = file
+		typeDef.Namespace = ns
 		types = append(types, typeDef)
 	}
-	log.Println("Collected", len(types), "YAML types")
+	return types, nil
+}
 
-	typeMap := make(map[string]*YamlType)
-	for i := range types {
-		typeDef := types[i]
-		typeMap[typeDef.Name()] = typeDef
+// Checks that the mixins of the classes are not cyclic, e.g. `A` includes `B`
+// and `B` includes `A`, and that the properties of the mixins do not have the
+// same names as other properties of the classes that include them.
+func (model *YamlModel) validateMixins() error {
+	done := make(map[string]bool)
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		for i, other := range path {
+			if other == name {
+				return fmt.Errorf("cyclic mixins: %s",
+					strings.Join(append(path[i:], name), " -> "))
+			}
+		}
+		if done[name] {
+			return nil
+		}
+		t := model.TypeMap[name]
+		if t == nil || !t.IsClass() {
+			return nil
+		}
+		for _, mixin := range t.Class.Mixins {
+			if err := visit(mixin, append(path, name)); err != nil {
+				return err
+			}
+		}
+		done[name] = true
+		return nil
+	}
+	for _, t := range model.Types {
+		if err := visit(t.Name(), nil); err != nil {
+			return err
+		}
 	}
 
-	model := YamlModel{Types: types, TypeMap: typeMap}
-
-	return &model, nil
+	for _, t := range model.Types {
+		if t.IsClass() {
+			if err := model.validateMixinProps(t.Class); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
-// AllPropsOf returns all properties of the given class including the properties
-// of all its parent classes.
-func (model *YamlModel) AllPropsOf(class *YamlClass) []*YamlProp {
-	props := make([]*YamlProp, 0, len(class.Props)+1)
-	c := class
-	for {
-		if c == nil {
+// Checks that the properties of the mixins of the given class and its super
+// classes do not have the same names as the properties of the class hierarchy
+// or the properties of other mixins.
+func (model *YamlModel) validateMixinProps(class *YamlClass) error {
+	owners := make(map[string]string)
+	var hierarchy []*YamlClass
+	visited := make(map[string]bool)
+	for c := class; c != nil && !visited[c.Name]; {
+		visited[c.Name] = true
+		hierarchy = append(hierarchy, c)
+		for _, prop := range c.Props {
+			owners[prop.Name] = c.Name
+		}
+		super := model.TypeMap[c.SuperClass]
+		if super == nil || !super.IsClass() {
 			break
 		}
-		props = append(props, c.Props...)
-		c = model.ParentOf(c)
+		c = super.Class
+	}
+
+	included := make(map[string]bool)
+	var include func(c *YamlClass) error
+	include = func(c *YamlClass) error {
+		for _, name := range c.Mixins {
+			t := model.TypeMap[name]
+			if t == nil || !t.IsClass() || included[name] {
+				continue
+			}
+			included[name] = true
+			for _, prop := range t.Class.Props {
+				if owner := owners[prop.Name]; owner != "" {
+					return fmt.Errorf("class %s: property %s of mixin %s has "+
+						"the same name as a property of %s",
+						class.Name, prop.Name, name, owner)
+				}
+				owners[prop.Name] = name
+			}
+			if err := include(t.Class); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, c := range hierarchy {
+		if err := include(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Checks that the type expressions of all properties are well-formed. Map
+// types must have exactly two type parameters where the key type has to be
+// `string` as we map them to JSON objects. Union types need at least two
+// alternatives and can be only used directly as property types (and not in
+// lists or maps) as we map them to `oneof` fields in proto3. Reference types
+// need exactly one reference class with the `x-ref` annotation.
+func (model *YamlModel) validatePropTypes() error {
+	var refClasses []string
+	for _, t := range model.Types {
+		if t.IsClass() && t.Class.IsRef() {
+			refClasses = append(refClasses, t.Name())
+		}
+	}
+	if len(refClasses) > 1 {
+		return fmt.Errorf("only one class can have the x-ref annotation: %s",
+			strings.Join(refClasses, ", "))
+	}
+
+	var validate func(t YamlPropType) error
+	validate = func(t YamlPropType) error {
+		if t.IsUnion() {
+			return fmt.Errorf("union types cannot be nested: %s", t)
+		}
+		if t.IsList() {
+			elem := t.UnpackList()
+			if elem.IsList() || elem.IsMap() {
+				return fmt.Errorf("lists of lists or maps are not supported, "+
+					"as they cannot be mapped to proto3: %s", t)
+			}
+			return validate(elem)
+		}
+		if t.IsRef() {
+			if len(refClasses) == 0 {
+				return fmt.Errorf("the reference type %s needs a class with "+
+					"the x-ref annotation", t)
+			}
+			return validate(t.UnpackRef())
+		}
+		if t.IsMap() {
+			key, value := t.UnpackMap()
+			if key == "" || value == "" {
+				return fmt.Errorf("invalid map type: %s", t)
+			}
+			if key != "string" {
+				return fmt.Errorf("invalid key type in %s: only string keys "+
+					"are supported", t)
+			}
+			if value.IsList() || value.IsMap() {
+				return fmt.Errorf("maps with list or map values are not "+
+					"supported, as they cannot be mapped to proto3: %s", t)
+			}
+			return validate(value)
+		}
+		return nil
+	}
+
+	for _, t := range model.Types {
+		if t.IsEnum() {
+			continue
+		}
+		for _, prop := range t.Class.Props {
+			propType := prop.PropType()
+			if propType.IsUnion() {
+				alternatives := propType.UnpackUnion()
+				if len(alternatives) < 2 {
+					return fmt.Errorf("property %s.%s: a union needs at least "+
+						"two alternatives: %s", t.Name(), prop.Name, propType)
+				}
+				for _, alt := range alternatives {
+					if alt == "" || strings.ContainsAny(string(alt), "[]") {
+						return fmt.Errorf("property %s.%s: invalid union "+
+							"alternative '%s' in %s", t.Name(), prop.Name, alt, propType)
+					}
+				}
+				continue
+			}
+			if err := validate(propType); err != nil {
+				return fmt.Errorf("property %s.%s: %w", t.Name(), prop.Name, err)
+			}
+		}
 	}
-	sort.Sort(YamlPropsByName(props))
-	return props
+	return nil
+}
+
+// AllPropsOf returns all properties of the given class including the properties
+// of all its parent classes and the mixins of these classes.
+func (model *YamlModel) AllPropsOf(class *YamlClass) []*YamlProp {
+	return model.Index().AllPropsOf(class)
 }
 
 // IsRoot returns true if the given class is a root entity. This is the case
 // when `RootEntity` is a parent class of the given class.
 func (model *YamlModel) IsRoot(class *YamlClass) bool {
-	c := class
-	for {
-		parent := model.ParentOf(c)
-		if parent == nil {
-			return false
+	return model.Index().IsRoot(class)
+}
+
+// The folders of openLCA zip packages that are not the plural of the class
+// name in snake case.
+var knownZipFolders = map[string]string{
+	"ImpactCategory": "lcia_categories",
+	"ImpactMethod":   "lcia_methods",
+}
+
+// ZipFolder returns the folder of the instances of the given class in zip
+// packages. This is the value of the `x-zip-folder` annotation or, by default,
+// the folder that openLCA uses for the class, which is the plural of the class
+// name in snake case, e.g. `unit_groups`, except for the impact categories and
+// methods that are stored in `lcia_categories` and `lcia_methods`.
+func (class *YamlClass) ZipFolder() string {
+	if folder := class.Annotations.String("x-zip-folder"); folder != "" {
+		return folder
+	}
+	if folder := knownZipFolders[class.Name]; folder != "" {
+		return folder
+	}
+	return toPlural(toSnakeName(class.Name))
+}
+
+// IsRef returns true if the given class is a reference to other entities, as
+// marked by the `x-ref` annotation. The `@type` of a reference is the type of
+// the referenced entity, so that the class is generic over that type in the
+// Python and TypeScript output.
+func (class *YamlClass) IsRef() bool {
+	return class.Annotations.Bool("x-ref")
+}
+
+// RefClass returns the reference class of the model, which is the class with
+// the `x-ref` annotation, or nil if there is no such class.
+func (model *YamlModel) RefClass() *YamlClass {
+	return model.Index().RefClass()
+}
+
+// HasToRef returns true if instances of the given class can be converted to
+// references, e.g. with the `to_ref` method in Python. This is the case for
+// root entities and classes with the `x-python-to-ref` annotation when the
+// model has a reference class. A profile that contains such a class also
+// contains the reference class.
+func (model *YamlModel) HasToRef(class *YamlClass) bool {
+	return model.RefClass() != nil &&
+		(model.IsRoot(class) || class.Annotations.Bool("x-python-to-ref"))
+}
+
+// Checks that the default values of the properties match their types. The
+// default values are normalized in this step so that maps have string keys
+// and numbers of floating point types are stored as float64 values.
+func (model *YamlModel) validateDefaults() error {
+	for _, t := range model.Types {
+		if t.IsEnum() {
+			continue
+		}
+		for _, prop := range t.Class.Props {
+			if prop.Default == nil {
+				continue
+			}
+			value, err := model.normalizeDefault(prop.PropType(), prop.Default)
+			if err != nil {
+				return fmt.Errorf("invalid default value of property %s.%s: %w",
+					t.Name(), prop.Name, err)
+			}
+			prop.Default = value
+		}
+	}
+	return nil
+}
+
+func (model *YamlModel) normalizeDefault(
+	t YamlPropType, value interface{}) (interface{}, error) {
+
+	if t.IsList() {
+		list, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%v is not a list", value)
+		}
+		elemType := t.UnpackList()
+		normalized := make([]interface{}, 0, len(list))
+		for _, elem := range list {
+			v, err := model.normalizeDefault(elemType, elem)
+			if err != nil {
+				return nil, err
+			}
+			normalized = append(normalized, v)
+		}
+		return normalized, nil
+	}
+
+	if t.IsMap() {
+		m, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%v is not a map", value)
+		}
+		_, valueType := t.UnpackMap()
+		normalized := make(map[string]interface{}, len(m))
+		for key, val := range m {
+			k, ok := key.(string)
+			if !ok {
+				return nil, fmt.Errorf("map key %v is not a string", key)
+			}
+			v, err := model.normalizeDefault(valueType, val)
+			if err != nil {
+				return nil, err
+			}
+			normalized[k] = v
+		}
+		return normalized, nil
+	}
+
+	if t.IsEnumOf(model) {
+		name, ok := value.(string)
+		if ok {
+			for _, item := range model.TypeMap[string(t)].Enum.Items {
+				if item.Name == name {
+					return name, nil
+				}
+			}
+		}
+		return nil, fmt.Errorf("%v is not an item of enum %s", value, t)
+	}
+
+	switch model.Primitives.RootOf(string(t)) {
+	case "string":
+		if s, ok := value.(string); ok {
+			return s, nil
+		}
+	case "double", "float":
+		switch v := value.(type) {
+		case int:
+			return float64(v), nil
+		case float64:
+			return v, nil
+		}
+	case "int", "integer":
+		if i, ok := value.(int); ok {
+			return i, nil
 		}
-		if parent.Name == "RootEntity" {
-			return true
+	case "boolean":
+		if b, ok := value.(bool); ok {
+			return b, nil
 		}
-		c = parent
+	default:
+		return nil, fmt.Errorf("default values are not supported for type %s", t)
 	}
+	return nil, fmt.Errorf("%v is not a valid %s value", value, t)
 }
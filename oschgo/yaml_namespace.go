@@ -0,0 +1,109 @@
+package main
+
+import (
+	"errors"
+	"io/fs"
+	"path"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+)
+
+// NamespaceFile is the name of the optional file that defines the namespace
+// of the types in a folder and its sub-folders.
+const NamespaceFile = "namespace.yaml"
+
+// YamlNamespace is the namespace of the types in a schema folder. Types that
+// are not in a namespace folder belong to the default namespace which is
+// represented by a nil value. The generators map a namespace to a proto3
+// package, a Python module, and a section in the documentation; if these are
+// not defined, they are derived from the name of the namespace.
+type YamlNamespace struct {
+	Name         string `yaml:"name"`
+	ProtoPackage string `yaml:"protoPackage,omitempty"`
+	PythonModule string `yaml:"pythonModule,omitempty"`
+	DocSection   string `yaml:"docSection,omitempty"`
+}
+
+// Reads the namespace file of the given folder of the source. Returns nil if
+// there is no such file. If the namespace has no name, the name of the folder
+// is used.
+func readNamespace(source *YamlSource, dir string) (*YamlNamespace, error) {
+	data, err := fs.ReadFile(source.FS, path.Join(dir, NamespaceFile))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	ns := &YamlNamespace{}
+	if err := yaml.Unmarshal(data, ns); err != nil {
+		return nil, err
+	}
+	if ns.Name == "" {
+		ns.Name = filepath.Base(source.PathOf(dir))
+	}
+	return ns, nil
+}
+
+// ProtoPackageOf returns the proto3 package of the namespace. For the default
+// namespace, this is the package of the manifest.
+func (ns *YamlNamespace) ProtoPackageOf(manifest *YamlManifest) string {
+	base := manifest.Generators.Proto.Package
+	if ns == nil {
+		return base
+	}
+	if ns.ProtoPackage != "" {
+		return ns.ProtoPackage
+	}
+	return base + "." + toSnakeCase(ns.Name)
+}
+
+// PyModule returns the name of the Python module of the namespace or an empty
+// string for the default namespace.
+func (ns *YamlNamespace) PyModule() string {
+	if ns == nil {
+		return ""
+	}
+	if ns.PythonModule != "" {
+		return ns.PythonModule
+	}
+	return toSnakeCase(ns.Name)
+}
+
+// Section returns the title of the documentation section of the namespace or
+// an empty string for the default namespace.
+func (ns *YamlNamespace) Section() string {
+	if ns == nil {
+		return ""
+	}
+	if ns.DocSection != "" {
+		return ns.DocSection
+	}
+	return ns.Name
+}
+
+// Namespaces returns the namespaces of the model in the order of their first
+// occurrence in the types. Namespaces with the same name, e.g. in different
+// input roots, are returned only once.
+func (model *YamlModel) Namespaces() []*YamlNamespace {
+	var namespaces []*YamlNamespace
+	seen := make(map[string]bool)
+	for _, t := range model.Types {
+		if t.Namespace == nil || seen[t.Namespace.Name] {
+			continue
+		}
+		seen[t.Namespace.Name] = true
+		namespaces = append(namespaces, t.Namespace)
+	}
+	return namespaces
+}
+
+// NamespaceName returns the name of the namespace of the type or an empty
+// string for the default namespace.
+func (t *YamlType) NamespaceName() string {
+	if t.Namespace == nil {
+		return ""
+	}
+	return t.Namespace.Name
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+)
+
+// YamlOverlay is a schema extension that is defined in a separate file with
+// an `overlay` key. It can add properties to existing classes, items to
+// existing enumerations, and new types. The properties and items of an
+// overlay have indices in the index range of the overlay. In proto3, these
+// indices are used as field numbers so that the field numbers of the base
+// schema do not change.
+type YamlOverlay struct {
+	Name      string           `yaml:"name"`
+	Doc       string           `yaml:"doc,omitempty"`
+	MinIndex  int              `yaml:"minIndex,omitempty"`
+	MaxIndex  int              `yaml:"maxIndex,omitempty"`
+	Extends   []*YamlExtension `yaml:"extends,omitempty"`
+	Types     []*YamlType      `yaml:"types,omitempty"`
+	File      string           `yaml:"-"`
+	Namespace *YamlNamespace   `yaml:"-"`
+}
+
+// YamlExtension adds properties to an existing class or items to an existing
+// enumeration.
+type YamlExtension struct {
+	Class string          `yaml:"class,omitempty"`
+	Enum  string          `yaml:"enum,omitempty"`
+	Props []*YamlProp     `yaml:"properties,omitempty"`
+	Items []*YamlEnumItem `yaml:"items,omitempty"`
+}
+
+// InRange returns true if the given index is in the index range of the
+// overlay.
+func (o *YamlOverlay) InRange(index int) bool {
+	return index >= o.MinIndex && index <= o.MaxIndex
+}
+
+// Returns the types of the overlay. They get the name of the overlay as origin
+// and the file and namespace of the overlay.
+func (o *YamlOverlay) types() []*YamlType {
+	for _, t := range o.Types {
+		t.Origin = o.Name
+		t.File = o.File
+		t.Namespace = o.Namespace
+	}
+	return o.Types
+}
+
+// Applies the extensions of the overlay to the given types.
+func (o *YamlOverlay) apply(typeMap map[string]*YamlType) error {
+	for _, ext := range o.Extends {
+		if ext.Class != "" {
+			t := typeMap[ext.Class]
+			if t == nil || !t.IsClass() {
+				return fmt.Errorf("%s: overlay %s extends unknown class %s",
+					o.File, o.Name, ext.Class)
+			}
+			for _, prop := range ext.Props {
+				for _, other := range t.Class.Props {
+					if other.Name == prop.Name {
+						return fmt.Errorf(
+							"%s: overlay %s: class %s already has a property %s",
+							o.File, o.Name, ext.Class, prop.Name)
+					}
+				}
+				prop.Origin = o.Name
+				t.Class.Props = append(t.Class.Props, prop)
+			}
+			continue
+		}
+
+		t := typeMap[ext.Enum]
+		if t == nil || !t.IsEnum() {
+			return fmt.Errorf("%s: overlay %s extends unknown enum %s",
+				o.File, o.Name, ext.Enum)
+		}
+		for _, item := range ext.Items {
+			for _, other := range t.Enum.Items {
+				if other.Name == item.Name {
+					return fmt.Errorf(
+						"%s: overlay %s: enum %s already has an item %s",
+						o.File, o.Name, ext.Enum, item.Name)
+				}
+			}
+			item.Origin = o.Name
+			t.Enum.Items = append(t.Enum.Items, item)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"gopkg.in/yaml.v2"
+)
+
+// PrimitivesFile is the name of the optional file in the YAML directory that
+// registers additional primitive types or overrides the built-in ones.
+const PrimitivesFile = "primitives.yaml"
+
+// YamlPrimitive describes a primitive type of the schema. The targets map the
+// primitive to the corresponding types of the generators: `proto`, `python`,
+// `ts` (TypeScript), and `json` (the JSON Schema type). A primitive can be
+// derived from a base type from which it inherits the target mappings,
+// format, and pattern that it does not define itself.
+type YamlPrimitive struct {
+	Name    string            `yaml:"name"`
+	Base    string            `yaml:"base,omitempty"`
+	Doc     string            `yaml:"doc,omitempty"`
+	Link    string            `yaml:"link,omitempty"`
+	Pattern string            `yaml:"pattern,omitempty"`
+	Format  string            `yaml:"format,omitempty"`
+	Targets map[string]string `yaml:"targets,omitempty"`
+}
+
+// YamlPrimitives is the registry of the primitive types by name.
+type YamlPrimitives map[string]*YamlPrimitive
+
+// The built-in primitive types of the schema.
+const builtinPrimitives = `
+- name: string
+  link: http://www.w3.org/TR/xmlschema-2/#string
+  targets: {proto: string, python: str, json: string, ts: string}
+- name: double
+  link: http://www.w3.org/TR/xmlschema-2/#double
+  targets: {proto: double, python: float, json: number, ts: number}
+- name: float
+  link: http://www.w3.org/TR/xmlschema-2/#float
+  targets: {proto: float, python: float, json: number, ts: number}
+- name: int
+  link: http://www.w3.org/TR/xmlschema-2/#int
+  targets: {proto: int32, python: int, json: integer, ts: number}
+- name: integer
+  link: http://www.w3.org/TR/xmlschema-2/#integer
+  targets: {proto: int32, python: int, json: integer, ts: number}
+- name: boolean
+  link: http://www.w3.org/TR/xmlschema-2/#boolean
+  targets: {proto: bool, python: bool, json: boolean, ts: boolean}
+- name: bool
+  base: boolean
+  link: http://www.w3.org/TR/xmlschema-2/#boolean
+- name: date
+  base: string
+  link: http://www.w3.org/TR/xmlschema-2/#date
+  format: date
+- name: dateTime
+  base: string
+  link: http://www.w3.org/TR/xmlschema-2/#dateTime
+  format: date-time
+- name: GeoJSON
+  link: https://tools.ietf.org/html/rfc7946
+  format: GeoJSON
+  targets:
+    proto: bytes
+    python: 'Dict[str, Any]'
+    json: object
+    ts: 'Record<string, unknown>'
+`
+
+// ReadPrimitives reads the primitive types from the roots of the given YAML
+// file systems and merges them with the built-in primitives. Primitives in the
+// file systems replace built-in primitives (or primitives of previous file
+// systems) with the same name.
+func ReadPrimitives(roots ...fs.FS) (YamlPrimitives, error) {
+	var builtins []*YamlPrimitive
+	if err := yaml.Unmarshal([]byte(builtinPrimitives), &builtins); err != nil {
+		return nil, err
+	}
+	primitives := make(YamlPrimitives)
+	for _, p := range builtins {
+		primitives[p.Name] = p
+	}
+
+	for _, root := range roots {
+		data, err := fs.ReadFile(root, PrimitivesFile)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+		var custom []*YamlPrimitive
+		if err := yaml.Unmarshal(data, &custom); err != nil {
+			return nil, err
+		}
+		for _, p := range custom {
+			if p.Name == "" {
+				return nil, fmt.Errorf("%s: primitive without name", PrimitivesFile)
+			}
+			primitives[p.Name] = p
+		}
+	}
+
+	// check that the base types exist and that there are no cycles
+	for _, p := range primitives {
+		visited := map[string]bool{}
+		for base := p; base.Base != ""; base = primitives[base.Base] {
+			if visited[base.Name] {
+				return nil, fmt.Errorf("%s: cyclic base type of %s",
+					PrimitivesFile, p.Name)
+			}
+			visited[base.Name] = true
+			if primitives[base.Base] == nil {
+				return nil, fmt.Errorf("%s: unknown base type %s of %s",
+					PrimitivesFile, base.Base, base.Name)
+			}
+		}
+	}
+	return primitives, nil
+}
+
+// Has returns true if there is a primitive type with the given name.
+func (r YamlPrimitives) Has(name string) bool {
+	return r[name] != nil
+}
+
+// lookup returns the first non-empty value of the given attribute in the base
+// type chain of the primitive with the given name.
+func (r YamlPrimitives) lookup(name string, attr func(*YamlPrimitive) string) string {
+	for p := r[name]; p != nil; p = r[p.Base] {
+		if v := attr(p); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// TargetOf returns the type to which the primitive with the given name is
+// mapped in the given target (`proto`, `python`, `ts`, or `json`). It returns an
+// empty string if there is no such mapping.
+func (r YamlPrimitives) TargetOf(name, target string) string {
+	return r.lookup(name, func(p *YamlPrimitive) string {
+		return p.Targets[target]
+	})
+}
+
+// PatternOf returns the validation pattern of the primitive with the given
+// name, if defined.
+func (r YamlPrimitives) PatternOf(name string) string {
+	return r.lookup(name, func(p *YamlPrimitive) string {
+		return p.Pattern
+	})
+}
+
+// FormatOf returns the format, like `date-time`, of the primitive with the
+// given name, if defined.
+func (r YamlPrimitives) FormatOf(name string) string {
+	return r.lookup(name, func(p *YamlPrimitive) string {
+		return p.Format
+	})
+}
+
+// RootOf returns the name of the type at the root of the base type chain of
+// the given primitive, e.g. `string` for `dateTime`. It returns an empty
+// string if the given name is not a primitive type.
+func (r YamlPrimitives) RootOf(name string) string {
+	p := r[name]
+	if p == nil {
+		return ""
+	}
+	for p.Base != "" {
+		p = r[p.Base]
+	}
+	return p.Name
+}
+
+// ConstraintsOf returns the constraints of the given property including the
+// validation pattern of its primitive type (or the primitive type of its
+// elements for lists and maps). A pattern of the property itself takes
+// precedence over the pattern of its type.
+func (model *YamlModel) ConstraintsOf(prop *YamlProp) *YamlConstraints {
+	valueType := prop.PropType()
+	if valueType.IsList() {
+		valueType = valueType.UnpackList()
+	} else if valueType.IsMap() {
+		_, valueType = valueType.UnpackMap()
+	}
+	pattern := model.Primitives.PatternOf(string(valueType))
+	if pattern == "" || (prop.Constraints != nil && prop.Constraints.Pattern != "") {
+		return prop.Constraints
+	}
+	c := YamlConstraints{}
+	if prop.Constraints != nil {
+		c = *prop.Constraints
+	}
+	c.Pattern = pattern
+	return &c
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+// YamlProfile defines a subset of the schema. It contains the types that are
+// listed in `include` and all types that they depend on: their super classes,
+// mixins, and the types of their properties. Properties can be excluded from
+// the profile with `Class.property` entries in `exclude`. References are not
+// followed, a `Ref[Flow]` property only adds the `Ref` type to the profile,
+// which is also added when a class of the profile can be converted to a
+// reference, like root entities.
+type YamlProfile struct {
+	Doc     string   `yaml:"doc"`
+	Include []string `yaml:"include"`
+	Exclude []string `yaml:"exclude"`
+}
+
+// ApplyProfile prunes the model to the types of the profile with the given
+// name from the manifest.
+func (model *YamlModel) ApplyProfile(name string) error {
+	profile := model.Manifest.Profiles[name]
+	if profile == nil {
+		return fmt.Errorf("unknown profile: %s", name)
+	}
+
+	// remove the excluded properties
+	for _, path := range profile.Exclude {
+		i := strings.Index(path, ".")
+		if i < 0 {
+			return fmt.Errorf("profile %s: invalid property %s; the format "+
+				"is Class.property", name, path)
+		}
+		t := model.TypeMap[path[:i]]
+		if t == nil || !t.IsClass() {
+			return fmt.Errorf("profile %s: unknown class %s", name, path[:i])
+		}
+		class := t.Class
+		props := make([]*YamlProp, 0, len(class.Props))
+		for _, prop := range class.Props {
+			if prop.Name != path[i+1:] {
+				props = append(props, prop)
+			}
+		}
+		if len(props) == len(class.Props) {
+			return fmt.Errorf("profile %s: unknown property %s", name, path)
+		}
+		class.Props = props
+	}
+
+	// compute the transitive closure of the included types
+	included := make(map[string]bool)
+	var include func(name string)
+	var includeType func(t YamlPropType)
+	include = func(name string) {
+		if included[name] {
+			return
+		}
+		t := model.TypeMap[name]
+		if t == nil {
+			return
+		}
+		included[name] = true
+		if t.IsEnum() {
+			return
+		}
+		class := t.Class
+		if class.SuperClass != "" {
+			include(class.SuperClass)
+		}
+		for _, mixin := range class.Mixins {
+			include(mixin)
+		}
+		for _, prop := range class.Props {
+			includeType(prop.PropType())
+		}
+	}
+	includeType = func(t YamlPropType) {
+		switch {
+		case t.IsList():
+			includeType(t.UnpackList())
+		case t.IsRef():
+			include(model.RefClass().Name)
+		case t.IsMap():
+			_, value := t.UnpackMap()
+			includeType(value)
+		case t.IsUnion():
+			for _, alt := range t.UnpackUnion() {
+				includeType(alt)
+			}
+		default:
+			include(string(t))
+		}
+	}
+	for _, root := range profile.Include {
+		if model.TypeMap[root] == nil {
+			return fmt.Errorf("profile %s: unknown type %s", name, root)
+		}
+		include(root)
+	}
+
+	// classes that can be converted to references need the reference class
+	for _, t := range model.Types {
+		if included[t.Name()] && t.IsClass() && model.HasToRef(t.Class) {
+			include(model.RefClass().Name)
+			break
+		}
+	}
+
+	// prune the model
+	types := make([]*YamlType, 0, len(included))
+	typeMap := make(map[string]*YamlType)
+	for _, t := range model.Types {
+		if included[t.Name()] {
+			types = append(types, t)
+			typeMap[t.Name()] = t
+		}
+	}
+	log.Println("Profile", name, "contains", len(types), "of",
+		len(model.Types), "types")
+	model.Types = types
+	model.TypeMap = typeMap
+	model.Profile = name
+	model.Resolve()
+	return nil
+}
+
+// Returns true if the class with the given name is in the model and has the
+// given property, which may have been excluded by a profile.
+func (model *YamlModel) hasProp(class string, prop *YamlProp) bool {
+	t := model.TypeMap[class]
+	if t == nil || !t.IsClass() {
+		return false
+	}
+	for _, p := range t.Class.Props {
+		if p == prop {
+			return true
+		}
+	}
+	return false
+}
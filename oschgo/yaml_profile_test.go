@@ -0,0 +1,118 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestProfileAddsRefForToRef(t *testing.T) {
+	model, err := readTestModel(t, map[string]string{
+		ManifestFile: `name: test
+profiles:
+  actors:
+    include: [Actor]
+`,
+		"Entity.yaml": `class:
+  name: Entity
+  properties:
+  - name: '@type'
+    type: string
+`,
+		"RootEntity.yaml": `class:
+  name: RootEntity
+  superClass: Entity
+  properties:
+  - name: name
+    type: string
+`,
+		"Actor.yaml": `class:
+  name: Actor
+  superClass: RootEntity
+  properties:
+  - name: email
+    type: string
+`,
+		"Ref.yaml": `class:
+  name: Ref
+  superClass: Entity
+  x-ref: true
+  properties:
+  - name: name
+    type: string
+`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := model.ApplyProfile("actors"); err != nil {
+		t.Fatal(err)
+	}
+	if model.TypeMap["Ref"] == nil {
+		t.Error("the profile should contain Ref for Actor.to_ref")
+	}
+}
+
+// A reference to a type that is not in the profile is a reference of any type.
+func TestProfileRefWithoutTarget(t *testing.T) {
+	model, err := readTestModel(t, map[string]string{
+		ManifestFile: `name: test
+profiles:
+  actors:
+    include: [Actor]
+`,
+		"Entity.yaml": `class:
+  name: Entity
+  properties:
+  - name: '@type'
+    type: string
+`,
+		"Actor.yaml": `class:
+  name: Actor
+  superClass: Entity
+  properties:
+  - name: flow
+    type: Ref[Flow]
+`,
+		"Flow.yaml": `class:
+  name: Flow
+  superClass: Entity
+`,
+		"Ref.yaml": `class:
+  name: Ref
+  superClass: Entity
+  x-ref: true
+`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := model.ApplyProfile("actors"); err != nil {
+		t.Fatal(err)
+	}
+	if model.TypeMap["Flow"] != nil {
+		t.Fatal("the profile should not contain Flow")
+	}
+	refType := YamlPropType("Ref[Flow]")
+	if pyType := refType.ToPython(model); pyType != "Ref[Any]" {
+		t.Error("expected Ref[Any] as Python type, got", pyType)
+	}
+	if tsType := model.tsTypeOf(refType); tsType != "Ref<"+tsTyped+">" {
+		t.Error("expected a reference of any type in TypeScript, got", tsType)
+	}
+	if targets := model.pyRefTargetsOf(refType.UnpackRef()); targets != nil {
+		t.Error("expected no targets for a missing type, got", targets)
+	}
+
+	// without root entities, there is no RootEntity union
+	classes, err := pyClassesOf(model)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buffer bytes.Buffer
+	w := &pyWriter{buff: &buffer, model: model, classes: classes}
+	w.writeModel()
+	if module := buffer.String(); strings.Contains(module, "RootEntity") {
+		t.Error("the module should not contain a RootEntity union:\n" + module)
+	}
+}
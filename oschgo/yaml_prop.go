@@ -1,34 +1,171 @@
 package main
 
 import (
+	"encoding/json"
+	"fmt"
 	"log"
+	"regexp"
+	"strconv"
 	"strings"
 )
 
 type YamlProp struct {
-	Name     string `yaml:"name"`
-	Index    int    `yaml:"index"`
-	Type     string `yaml:"type"`
-	Doc      string `yaml:"doc"`
-	Required bool   `yaml:"required"`
+	Name        string           `yaml:"name"`
+	Index       int              `yaml:"index,omitempty"`
+	Type        string           `yaml:"type,omitempty"`
+	Doc         string           `yaml:"doc,omitempty"`
+	Required    bool             `yaml:"required,omitempty"`
+	Constraints *YamlConstraints `yaml:"constraints,omitempty"`
+	Default     interface{}      `yaml:"default,omitempty"`
+	Annotations YamlAnnotations  `yaml:",inline"`
+
+	YamlVersioning `yaml:",inline"`
+
+	// the name of the overlay that added the property
+	Origin string `yaml:"-"`
+}
+
+// YamlConstraints are optional constraints of property values. For list and
+// map types, the length constraints apply to the number of elements and the
+// other constraints to the elements themselves.
+type YamlConstraints struct {
+	Min          *float64 `yaml:"min,omitempty"`
+	Max          *float64 `yaml:"max,omitempty"`
+	ExclusiveMin *float64 `yaml:"exclusiveMin,omitempty"`
+	ExclusiveMax *float64 `yaml:"exclusiveMax,omitempty"`
+	Pattern      string   `yaml:"pattern,omitempty"`
+	MinLength    *int     `yaml:"minLength,omitempty"`
+	MaxLength    *int     `yaml:"maxLength,omitempty"`
+	Unit         string   `yaml:"unit,omitempty"`
+}
+
+func (c *YamlConstraints) IsEmpty() bool {
+	return c == nil || len(c.Describe()) == 0
+}
+
+// Describe returns a human readable description of each constraint, like
+// `minimum: 1`, in a fixed order.
+func (c *YamlConstraints) Describe() []string {
+	if c == nil {
+		return nil
+	}
+	num := func(f float64) string {
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	}
+	var items []string
+	if c.Min != nil {
+		items = append(items, "minimum: "+num(*c.Min))
+	}
+	if c.ExclusiveMin != nil {
+		items = append(items, "exclusive minimum: "+num(*c.ExclusiveMin))
+	}
+	if c.Max != nil {
+		items = append(items, "maximum: "+num(*c.Max))
+	}
+	if c.ExclusiveMax != nil {
+		items = append(items, "exclusive maximum: "+num(*c.ExclusiveMax))
+	}
+	if c.Pattern != "" {
+		items = append(items, "pattern: `"+c.Pattern+"`")
+	}
+	if c.MinLength != nil {
+		items = append(items, "min. length: "+strconv.Itoa(*c.MinLength))
+	}
+	if c.MaxLength != nil {
+		items = append(items, "max. length: "+strconv.Itoa(*c.MaxLength))
+	}
+	if c.Unit != "" {
+		items = append(items, "unit: "+c.Unit)
+	}
+	return items
+}
+
+// DefaultJSON returns the JSON representation of the default value of the
+// property or an empty string if the property has no default value.
+func (p *YamlProp) DefaultJSON() string {
+	if p.Default == nil {
+		return ""
+	}
+	data, err := json.Marshal(p.Default)
+	if err != nil {
+		return fmt.Sprint(p.Default)
+	}
+	return string(data)
 }
 
 func (p *YamlProp) PropType() YamlPropType {
 	return YamlPropType(p.Type)
 }
 
+// The default names of the JSON-LD properties in the generated code when the
+// properties have no `x-python-name` or `x-proto-name` annotation.
+var (
+	pyDefaultNames    = map[string]string{"@type": "schema_type", "@id": "id"}
+	protoDefaultNames = map[string]string{"@type": "type", "@id": "id"}
+)
+
+var identifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ProtoName returns the name of the property in proto3 messages. This is the
+// `x-proto-name` annotation of the property, if present, the default name of
+// a JSON-LD property like `@type`, or the snake case name of the property.
+func (prop *YamlProp) ProtoName() string {
+	if name := prop.Annotations.String("x-proto-name"); name != "" {
+		return name
+	}
+	if name, ok := protoDefaultNames[prop.Name]; ok {
+		return name
+	}
+	return toSnakeCase(prop.Name)
+}
+
+// PyName returns the name of the property in the generated Python class. This
+// is the `x-python-name` annotation of the property, if present, the default
+// name of a JSON-LD property like `@type`, or the snake case name of the
+// property with a trailing underscore if it is a keyword.
 func (prop *YamlProp) PyName() string {
-	name := prop.Name
-	switch name {
-	case "@type":
-		return "schema_type"
-	case "@id":
-		return "id"
-	case "from":
-		return "from_"
-	default:
-		return toSnakeCase(name)
+	if name := prop.Annotations.String("x-python-name"); name != "" {
+		return name
+	}
+	if name, ok := pyDefaultNames[prop.Name]; ok {
+		return name
+	}
+	name := toSnakeCase(prop.Name)
+	if pyKeywords[name] {
+		return name + "_"
+	}
+	return name
+}
+
+// Returns an error if the Python name of the property is not a valid
+// identifier.
+func (prop *YamlProp) checkPyName() error {
+	if !identifier.MatchString(prop.PyName()) {
+		return fmt.Errorf("'%s' is not a valid Python name; use the "+
+			"x-python-name annotation", prop.PyName())
 	}
+	return nil
+}
+
+// Returns an error if the proto3 name of the property is not a valid
+// identifier, unless the property is skipped in proto3.
+func (prop *YamlProp) checkProtoName() error {
+	if !prop.Annotations.Bool("x-proto-skip") &&
+		!identifier.MatchString(prop.ProtoName()) {
+		return fmt.Errorf("'%s' is not a valid proto3 name; use the "+
+			"x-proto-name or x-proto-skip annotation", prop.ProtoName())
+	}
+	return nil
+}
+
+var pyKeywords = map[string]bool{
+	"and": true, "as": true, "assert": true, "async": true, "await": true,
+	"break": true, "class": true, "continue": true, "def": true, "del": true,
+	"elif": true, "else": true, "except": true, "finally": true, "for": true,
+	"from": true, "global": true, "if": true, "import": true, "in": true,
+	"is": true, "lambda": true, "nonlocal": true, "not": true, "or": true,
+	"pass": true, "raise": true, "return": true, "try": true, "while": true,
+	"with": true, "yield": true, "None": true, "True": true, "False": true,
 }
 
 type YamlPropsByName []*YamlProp
@@ -63,8 +200,23 @@ func (t YamlPropType) IsList() bool {
 	return strings.HasPrefix(string(t), "List[")
 }
 
-func (t YamlPropType) IsPrimitive() bool {
-	return startsWithLower(string(t))
+// IsPrimitiveOf returns true if the type is registered as a primitive type in
+// the given model.
+func (t YamlPropType) IsPrimitiveOf(model *YamlModel) bool {
+	return model.Primitives.Has(string(t))
+}
+
+func (t YamlPropType) IsNumericOf(model *YamlModel) bool {
+	switch model.Primitives.RootOf(string(t)) {
+	case "double", "float", "int", "integer":
+		return true
+	default:
+		return false
+	}
+}
+
+func (t YamlPropType) IsTextOf(model *YamlModel) bool {
+	return model.Primitives.RootOf(string(t)) == "string"
 }
 
 func (t YamlPropType) IsEnumOf(model *YamlModel) bool {
@@ -88,6 +240,36 @@ func (t YamlPropType) UnpackList() YamlPropType {
 	return YamlPropType(strings.TrimSuffix(s, "]"))
 }
 
+func (t YamlPropType) IsMap() bool {
+	return strings.HasPrefix(string(t), "Map[")
+}
+
+// UnpackMap returns the key and value types of a `Map[K, V]` type. It returns
+// empty types if the map type is not well-formed.
+func (t YamlPropType) UnpackMap() (YamlPropType, YamlPropType) {
+	s := strings.TrimPrefix(string(t), "Map[")
+	args := splitTypeArgs(strings.TrimSuffix(s, "]"))
+	if len(args) != 2 {
+		return "", ""
+	}
+	return YamlPropType(args[0]), YamlPropType(args[1])
+}
+
+func (t YamlPropType) IsUnion() bool {
+	return strings.HasPrefix(string(t), "Union[")
+}
+
+// UnpackUnion returns the alternatives of a `Union[A, B, ...]` type.
+func (t YamlPropType) UnpackUnion() []YamlPropType {
+	s := strings.TrimPrefix(string(t), "Union[")
+	args := splitTypeArgs(strings.TrimSuffix(s, "]"))
+	alternatives := make([]YamlPropType, 0, len(args))
+	for _, arg := range args {
+		alternatives = append(alternatives, YamlPropType(arg))
+	}
+	return alternatives
+}
+
 func (t YamlPropType) IsRef() bool {
 	return strings.HasPrefix(string(t), "Ref[")
 }
@@ -97,31 +279,69 @@ func (t YamlPropType) UnpackRef() YamlPropType {
 	return YamlPropType(strings.TrimSuffix(s, "]"))
 }
 
-func (t YamlPropType) ToPython() string {
+func (t YamlPropType) ToPython(model *YamlModel) string {
 	if t.IsList() {
 		param := t.UnpackList()
-		return "List[" + param.ToPython() + "]"
+		return "List[" + param.ToPython(model) + "]"
+	}
+	if t.IsMap() {
+		key, value := t.UnpackMap()
+		return "Dict[" + key.ToPython(model) + ", " + value.ToPython(model) + "]"
 	}
 	if t.IsRef() {
-		return "Ref"
-	}
-	switch t {
-	case "string", "date", "dateTime":
-		return "str"
-	case "double", "float":
-		return "float"
-	case "int", "integer":
-		return "int"
-	case "bool", "boolean":
-		return "bool"
-	case "GeoJSON":
-		return "Dict[str, Any]"
-	default:
-		if startsWithLower(string(t)) {
-			log.Println("WARNING: unknown primitive type:", t)
-			return "object"
-		} else {
-			return string(t)
+		// the target can be missing when it was removed by a profile
+		target := t.UnpackRef()
+		if model.TypeMap[string(target)] == nil {
+			return model.RefClass().Name + "[Any]"
+		}
+		return model.RefClass().Name + "[" + target.ToPython(model) + "]"
+	}
+	if t.IsUnion() {
+		alternatives := t.UnpackUnion()
+		names := make([]string, 0, len(alternatives))
+		for _, alt := range alternatives {
+			names = append(names, alt.ToPython(model))
+		}
+		return "Union[" + strings.Join(names, ", ") + "]"
+	}
+	if t.IsPrimitiveOf(model) {
+		switch model.pyDateKindOf(t) {
+		case "date":
+			return "datetime.date"
+		case "dateTime":
+			return "datetime.datetime"
+		}
+		if pyType := model.Primitives.TargetOf(string(t), "python"); pyType != "" {
+			return pyType
+		}
+		log.Println("WARNING: no Python type defined for primitive:", t)
+		return "object"
+	}
+	if startsWithLower(string(t)) {
+		log.Println("WARNING: unknown primitive type:", t)
+		return "object"
+	}
+	return string(t)
+}
+
+// Splits the given type arguments at the top-level commas, e.g.
+// `string, List[Ref[Flow]]` into `string` and `List[Ref[Flow]]`.
+func splitTypeArgs(s string) []string {
+	var args []string
+	depth := 0
+	start := 0
+	for i, char := range s {
+		switch char {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case ',':
+			if depth == 0 {
+				args = append(args, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
 		}
 	}
+	return append(args, strings.TrimSpace(s[start:]))
 }
@@ -0,0 +1,291 @@
+package main
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// YamlSource is an input root of the schema model. The YAML files are read
+// from the file system of the source; its name is used as prefix of the file
+// paths in messages. With an embedded file system, a schema can be read from
+// the binary itself:
+//
+//	//go:embed yaml
+//	var schema embed.FS
+//
+//	root, _ := fs.Sub(schema, "yaml")
+//	model, err := ReadYamlSources(&YamlSource{Name: "yaml", FS: root})
+type YamlSource struct {
+	Name string
+	FS   fs.FS
+}
+
+// DirSource returns the source of the given folder.
+func DirSource(dir string) *YamlSource {
+	return &YamlSource{Name: dir, FS: os.DirFS(dir)}
+}
+
+// PathOf returns the path of the given file of the source for messages.
+func (s *YamlSource) PathOf(file string) string {
+	return filepath.Join(s.Name, filepath.FromSlash(file))
+}
+
+// OpenSource opens the input root with the given path. This is a folder or a
+// zip or tar archive. The path can continue into the folders of an archive,
+// e.g. `olca-schema.zip/olca-schema/yaml`.
+func OpenSource(p string) (*YamlSource, error) {
+	if isDir(p) {
+		return DirSource(p), nil
+	}
+
+	// find the archive in the path
+	archive, inner := p, "."
+	for {
+		info, err := os.Stat(archive)
+		if err == nil && !info.IsDir() {
+			break
+		}
+		parent := filepath.Dir(archive)
+		if parent == archive || (err == nil && info.IsDir()) {
+			return nil, fmt.Errorf("input root does not exist: %s", p)
+		}
+		inner = path.Join(filepath.Base(archive), inner)
+		archive = parent
+	}
+
+	fsys, err := OpenArchive(archive)
+	if err != nil {
+		return nil, err
+	}
+	if inner != "." {
+		if info, err := fs.Stat(fsys, inner); err != nil || !info.IsDir() {
+			return nil, fmt.Errorf("input root does not exist: %s", p)
+		}
+		if fsys, err = fs.Sub(fsys, inner); err != nil {
+			return nil, err
+		}
+	}
+	return &YamlSource{Name: p, FS: fsys}, nil
+}
+
+// RevSource returns the source of the given folder at a git revision, like a
+// tag or commit. It is read with the local git binary, so the folder has to
+// be in a git working tree.
+func RevSource(dir, rev string) (*YamlSource, error) {
+	// git archive is restricted to the working directory, so we run it in
+	// the top-level folder with the path of the input folder in the tree
+	out, err := gitOutput(dir, "rev-parse", "--show-toplevel", "--show-prefix")
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(string(out), "\n")
+	if len(lines) < 2 {
+		return nil, fmt.Errorf("failed to get the git path of %s", dir)
+	}
+	tree := rev + ":" + strings.TrimSpace(lines[1])
+	data, err := gitOutput(lines[0], "archive", "--format=tar", tree)
+	if err != nil {
+		return nil, err
+	}
+	fsys, err := TarFS(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	return &YamlSource{Name: rev + ":" + dir, FS: fsys}, nil
+}
+
+// Runs git with the given arguments in the given folder and returns its
+// output.
+func gitOutput(dir string, args ...string) ([]byte, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return nil, fmt.Errorf("git %s: %s", strings.Join(args, " "),
+				strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return nil, err
+	}
+	return out, nil
+}
+
+// OpenArchive opens the zip or tar archive with the given file name as a file
+// system. Tar archives can be compressed with gzip.
+func OpenArchive(file string) (fs.FS, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.ToLower(file)
+	switch {
+	case strings.HasSuffix(name, ".zip"):
+		return ZipFS(data)
+	case strings.HasSuffix(name, ".tar"):
+		return TarFS(bytes.NewReader(data))
+	case strings.HasSuffix(name, ".tar.gz"), strings.HasSuffix(name, ".tgz"):
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		return TarFS(r)
+	default:
+		return nil, fmt.Errorf("unsupported archive format: %s", file)
+	}
+}
+
+// ZipFS returns the file system of the given zip data.
+func ZipFS(data []byte) (fs.FS, error) {
+	return zip.NewReader(bytes.NewReader(data), int64(len(data)))
+}
+
+// TarFS reads the files of the given tar stream into an in-memory file
+// system. Only regular files are kept; folders are derived from the file
+// paths.
+func TarFS(r io.Reader) (fs.FS, error) {
+	files := memFS{}
+	reader := tar.NewReader(r)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		name := path.Clean(strings.TrimPrefix(header.Name, "./"))
+		if !fs.ValidPath(name) {
+			return nil, fmt.Errorf("invalid path in tar archive: %s", header.Name)
+		}
+		data, err := ioutil.ReadAll(reader)
+		if err != nil {
+			return nil, err
+		}
+		files[name] = &memFile{
+			name:    path.Base(name),
+			data:    data,
+			mode:    fs.FileMode(header.Mode).Perm(),
+			modTime: header.ModTime,
+		}
+	}
+	return files, nil
+}
+
+// memFS is a read-only in-memory file system that maps the paths of its files
+// to their content. Folders are derived from the file paths.
+type memFS map[string]*memFile
+
+// memFile is a file or folder of a memFS; it is also its own file info and
+// directory entry.
+type memFile struct {
+	name    string
+	data    []byte
+	mode    fs.FileMode
+	modTime time.Time
+}
+
+func (f *memFile) Name() string               { return f.name }
+func (f *memFile) Size() int64                { return int64(len(f.data)) }
+func (f *memFile) Mode() fs.FileMode          { return f.mode }
+func (f *memFile) ModTime() time.Time         { return f.modTime }
+func (f *memFile) IsDir() bool                { return f.mode.IsDir() }
+func (f *memFile) Sys() interface{}           { return nil }
+func (f *memFile) Type() fs.FileMode          { return f.mode.Type() }
+func (f *memFile) Info() (fs.FileInfo, error) { return f, nil }
+
+func (fsys memFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	if file := fsys[name]; file != nil {
+		return &memReader{file, bytes.NewReader(file.data)}, nil
+	}
+
+	// collect the entries of the folder with the given path
+	prefix := name + "/"
+	if name == "." {
+		prefix = ""
+	}
+	folders := make(map[string]bool)
+	var entries []fs.DirEntry
+	for p, file := range fsys {
+		if !strings.HasPrefix(p, prefix) {
+			continue
+		}
+		rest := p[len(prefix):]
+		if i := strings.Index(rest, "/"); i >= 0 {
+			if !folders[rest[:i]] {
+				folders[rest[:i]] = true
+				entries = append(entries,
+					&memFile{name: rest[:i], mode: fs.ModeDir | 0555})
+			}
+			continue
+		}
+		entries = append(entries, file)
+	}
+	if len(entries) == 0 && name != "." {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+	info := &memFile{name: path.Base(name), mode: fs.ModeDir | 0555}
+	return &memDir{info: info, entries: entries}, nil
+}
+
+// memReader is an opened file of a memFS.
+type memReader struct {
+	file *memFile
+	*bytes.Reader
+}
+
+func (r *memReader) Stat() (fs.FileInfo, error) { return r.file, nil }
+func (r *memReader) Close() error               { return nil }
+
+// memDir is an opened folder of a memFS.
+type memDir struct {
+	info    *memFile
+	entries []fs.DirEntry
+	offset  int
+}
+
+func (d *memDir) Stat() (fs.FileInfo, error) { return d.info, nil }
+func (d *memDir) Close() error               { return nil }
+
+func (d *memDir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.info.name, Err: fs.ErrInvalid}
+}
+
+func (d *memDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	rest := d.entries[d.offset:]
+	if n <= 0 {
+		d.offset = len(d.entries)
+		return rest, nil
+	}
+	if len(rest) == 0 {
+		return nil, io.EOF
+	}
+	if n > len(rest) {
+		n = len(rest)
+	}
+	d.offset += n
+	return rest[:n], nil
+}
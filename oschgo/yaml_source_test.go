@@ -0,0 +1,45 @@
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"testing"
+	"testing/fstest"
+)
+
+func TestTarFS(t *testing.T) {
+	var buff bytes.Buffer
+	writer := tar.NewWriter(&buff)
+	files := [][2]string{
+		{"README.md", "# Schema"},
+		{"yaml/Unit.yaml", "class:\n  name: Unit\n"},
+		{"yaml/epd/Epd.yaml", "class:\n  name: Epd\n"},
+	}
+	for _, file := range files {
+		data := []byte(file[1])
+		header := &tar.Header{
+			Name:     "./" + file[0],
+			Mode:     0644,
+			Size:     int64(len(data)),
+			Typeflag: tar.TypeReg,
+		}
+		if err := writer.WriteHeader(header); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := writer.Write(data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	fsys, err := TarFS(&buff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := fstest.TestFS(fsys, "README.md", "yaml/Unit.yaml",
+		"yaml/epd/Epd.yaml"); err != nil {
+		t.Fatal(err)
+	}
+}
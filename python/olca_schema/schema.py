@@ -3,17 +3,48 @@
 # This module contains a Python API for reading and writing data sets in
 # the JSON based openLCA data exchange format. For more information see
 # http://greendelta.github.io/olca-schema
+#
+# Schema version: 2.0.0
+
+from __future__ import annotations
 
 import datetime
 import json
+import re
 import uuid
+import warnings
 
 from enum import Enum
-from dataclasses import dataclass
-from typing import Any, Dict, List, Optional, Union
+from dataclasses import dataclass, field
+from typing import Any, Dict, Generic, List, Optional, TypeVar, Union
+
+
+SCHEMA_VERSION = '2.0.0'
+
+_T = TypeVar('_T')
+
+
+def _check_value(value: Any, types: Any, type_name: str, path: str,
+                 errors: List[str]) -> bool:
+    valid = isinstance(value, types)
+    if isinstance(value, bool):
+        # a bool is an int in Python but not a valid number
+        valid = types is bool
+    if not valid:
+        errors.append(f'{path}: expected {type_name} but got {value!r}')
+        return False
+    if hasattr(value, 'validate'):
+        for error in value.validate():
+            errors.append(f'{path}.{error}')
+    return True
 
 
 class AllocationType(Enum):
+    """An enumeration type for allocation methods. This type is used to define
+    the type of an [AllocationFactor], the default allocation method of a
+    multi-functional [Process], or the allocation method in a
+    [CalculationSetup].
+    """
 
     PHYSICAL_ALLOCATION = 'PHYSICAL_ALLOCATION'
     ECONOMIC_ALLOCATION = 'ECONOMIC_ALLOCATION'
@@ -23,20 +54,44 @@ class AllocationType(Enum):
 
 
 class CalculationType(Enum):
+    """An enumeration of the different calculation methods supported by
+    openLCA.
+    """
 
     SIMPLE_CALCULATION = 'SIMPLE_CALCULATION'
+    """Calculates the total results for elementary flows, LCIA indicators,
+    costs, etc. of a product system.
+    """
     CONTRIBUTION_ANALYSIS = 'CONTRIBUTION_ANALYSIS'
+    """Includes the total result vectors of a simple calculation but calculates
+    also the direct contributions of each process (or better process product
+    in case of multi-output processes) to these total results.
+    """
     UPSTREAM_ANALYSIS = 'UPSTREAM_ANALYSIS'
+    """Extends the contribution analysis by providing also the upstream results
+    of each process (process product) in the product system. The upstream
+    result contains the direct contributions of the respective process but
+    also the result of the supply chain up to this process scaled to the
+    demand of the process in the product system.
+    """
     MONTE_CARLO_SIMULATION = 'MONTE_CARLO_SIMULATION'
+    """A Monte Carlo simulation generates for each run, of a given number of a
+    given number of iterations, random values according to the uncertainty
+    distributions of process inputs/outputs, parameters, characterization
+    factors, etc. of a product system and then performs a simple calculation
+    for that specific run.
+    """
 
 
 class FlowPropertyType(Enum):
+    """An enumeration of flow property types."""
 
     ECONOMIC_QUANTITY = 'ECONOMIC_QUANTITY'
     PHYSICAL_QUANTITY = 'PHYSICAL_QUANTITY'
 
 
 class FlowType(Enum):
+    """The basic flow types."""
 
     ELEMENTARY_FLOW = 'ELEMENTARY_FLOW'
     PRODUCT_FLOW = 'PRODUCT_FLOW'
@@ -44,6 +99,7 @@ class FlowType(Enum):
 
 
 class ModelType(Enum):
+    """An enumeration of the root entity types."""
 
     ACTOR = 'ACTOR'
     CATEGORY = 'CATEGORY'
@@ -66,10 +122,23 @@ class ModelType(Enum):
 
 
 class ParameterScope(Enum):
+    """The possible scopes of parameters. Parameters can be defined globally,
+    in processes, or impact categories. They can be redefined in calculation
+    setups on the project and product system level, but the initial
+    definition is always only global, in a process, or an LCIA category.
+    """
 
     PROCESS_SCOPE = 'PROCESS_SCOPE'
+    """Indicates that the evaluation scope of a parameter is the process where
+    it is defined.
+    """
     IMPACT_SCOPE = 'IMPACT_SCOPE'
+    """Indicates that the evaluation scope of a parameter is the impact
+    category where it is defined.
+    """
     GLOBAL_SCOPE = 'GLOBAL_SCOPE'
+    """Indicates that the evaluation scope of a parameter is the global scope.
+    """
 
 
 class ProcessType(Enum):
@@ -95,6 +164,9 @@ class RiskLevel(Enum):
 
 
 class UncertaintyType(Enum):
+    """Enumeration of uncertainty distribution types that can be used in
+    exchanges, parameters, LCIA factors, etc.
+    """
 
     LOG_NORMAL_DISTRIBUTION = 'LOG_NORMAL_DISTRIBUTION'
     NORMAL_DISTRIBUTION = 'NORMAL_DISTRIBUTION'
@@ -103,43 +175,217 @@ class UncertaintyType(Enum):
 
 
 @dataclass
-class DQScore:
+class AllocationFactor:
+    """A single allocation factor in a process."""
 
-    description: Optional[str] = None
-    label: Optional[str] = None
-    position: Optional[int] = None
-    uncertainty: Optional[float] = None
+    allocation_type: Optional[AllocationType] = None
+    """The type of allocation."""
+    exchange: Optional[ExchangeRef] = None
+    """A product input, waste output, or elementary flow exchange which is
+    allocated by this factor. This is only valid for causal allocation where
+    allocation factors can be assigned to single exchanges.
+    """
+    formula: Optional[str] = None
+    """An optional formula from which the value of the allocation factor is
+    calculated.
+    """
+    product: Optional[Ref[Flow]] = None
+    """The output product (or waste input) to which this allocation factor is
+    related. The must be an exchange with this product output (or waste
+    input) in this process.
+    """
+    value: Optional[float] = None
+    """The value of the allocation factor."""
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        if self.description:
-            d['description'] = self.description
-        if self.label:
-            d['label'] = self.label
-        if self.position:
-            d['position'] = self.position
-        if self.uncertainty:
-            d['uncertainty'] = self.uncertainty
+        if self.allocation_type is not None:
+            d['allocationType'] = self.allocation_type.value
+        if self.exchange is not None:
+            d['exchange'] = self.exchange.to_dict()
+        if self.formula is not None:
+            d['formula'] = self.formula
+        if self.product is not None:
+            d['product'] = self.product.to_dict()
+        if self.value is not None:
+            d['value'] = self.value
         return d
 
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'DQScore':
-        d_q_score = DQScore()
-        if v := d.get('@type'):
-            d_q_score.schema_type = v
-        if v := d.get('description'):
-            d_q_score.description = v
-        if v := d.get('label'):
-            d_q_score.label = v
-        if v := d.get('position'):
-            d_q_score.position = v
-        if v := d.get('uncertainty'):
-            d_q_score.uncertainty = v
-        return d_q_score
+    def from_dict(d: Dict[str, Any]) -> 'AllocationFactor':
+        allocation_factor = AllocationFactor()
+        if (v := d.get('allocationType')) is not None:
+            allocation_factor.allocation_type = AllocationType(v)
+        if (v := d.get('exchange')) is not None:
+            allocation_factor.exchange = ExchangeRef.from_dict(v)
+        if (v := d.get('formula')) is not None:
+            allocation_factor.formula = v
+        if (v := d.get('product')) is not None:
+            allocation_factor.product = Ref.from_dict(v, 'Flow')
+        if (v := d.get('value')) is not None:
+            allocation_factor.value = v
+        return allocation_factor
+
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.allocation_type is None:
+            errors.append('allocation_type: value is required')
+        else:
+            _check_value(self.allocation_type, AllocationType, 'AllocationType', 'allocation_type', errors)
+        if self.exchange is not None:
+            _check_value(self.exchange, ExchangeRef, 'ExchangeRef', 'exchange', errors)
+        if self.formula is not None:
+            _check_value(self.formula, str, 'string', 'formula', errors)
+        if self.product is None:
+            errors.append('product: value is required')
+        else:
+            _check_value(self.product, Ref, 'Ref[Flow]', 'product', errors)
+        if self.value is None:
+            errors.append('value: value is required')
+        else:
+            _check_value(self.value, (int, float), 'double', 'value', errors)
+        return errors
+
+
+@dataclass
+class CalculationSetup:
+    """A setup for a product system calculation."""
+
+    allocation: Optional[AllocationType] = None
+    """The calculation type to be used in the calculation."""
+    amount: Optional[float] = None
+    """The amount of the reference flow of the calculation target for which the
+    result should be calculated.
+    """
+    calculation_type: Optional[CalculationType] = None
+    """The type of calculation that should be performed."""
+    flow_property: Optional[Ref[FlowProperty]] = None
+    """The flow property of the amount of the reference flow for which a result
+    should be calculated. If no flow property is provided it defaults to the
+    reference flow property of the respective flow.
+    """
+    impact_method: Optional[Ref[ImpactMethod]] = None
+    """The LCIA method for the calculation."""
+    number_of_runs: Optional[int] = None
+    """This field is only valid when this setup describes a Monte Carlo
+    simulation and contains the number of simulation runs in that case.
+    """
+    nw_set: Optional[Ref[NwSet]] = None
+    """The normalisation and weighting set for the calculation."""
+    parameters: Optional[List[ParameterRedef]] = None
+    """A list of parameter redefinitions that should be used in the
+    calculation.
+    """
+    target: Optional[Ref] = None
+    """The product system or process that should be calculated. Note that a
+    result is calculated for the reference flow of that calculation target
+    (i.e. the reference flow of the product system or process).
+    """
+    unit: Optional[Ref[Unit]] = None
+    """The unit of the amount of the reference flow for which a result should
+    be calculated. If no unit is provided it defaults to the reference unit
+    of the respective flow.
+    """
+    with_costs: Optional[bool] = None
+    """Indicates whether life cycle costs should be also calculated."""
+    with_regionalization: Optional[bool] = None
+    """Indicates whether a regionalized result should be calculated or not."""
+
+    def to_dict(self) -> Dict[str, Any]:
+        d: Dict[str, Any] = {}
+        if self.allocation is not None:
+            d['allocation'] = self.allocation.value
+        if self.amount is not None:
+            d['amount'] = self.amount
+        if self.calculation_type is not None:
+            d['calculationType'] = self.calculation_type.value
+        if self.flow_property is not None:
+            d['flowProperty'] = self.flow_property.to_dict()
+        if self.impact_method is not None:
+            d['impactMethod'] = self.impact_method.to_dict()
+        if self.number_of_runs is not None:
+            d['numberOfRuns'] = self.number_of_runs
+        if self.nw_set is not None:
+            d['nwSet'] = self.nw_set.to_dict()
+        if self.parameters is not None:
+            d['parameters'] = [e.to_dict() for e in self.parameters]
+        if self.target is not None:
+            d['target'] = self.target.to_dict()
+        if self.unit is not None:
+            d['unit'] = self.unit.to_dict()
+        if self.with_costs is not None:
+            d['withCosts'] = self.with_costs
+        if self.with_regionalization is not None:
+            d['withRegionalization'] = self.with_regionalization
+        return d
+
+    @staticmethod
+    def from_dict(d: Dict[str, Any]) -> 'CalculationSetup':
+        calculation_setup = CalculationSetup()
+        if (v := d.get('allocation')) is not None:
+            calculation_setup.allocation = AllocationType(v)
+        if (v := d.get('amount')) is not None:
+            calculation_setup.amount = v
+        if (v := d.get('calculationType')) is not None:
+            calculation_setup.calculation_type = CalculationType(v)
+        if (v := d.get('flowProperty')) is not None:
+            calculation_setup.flow_property = Ref.from_dict(v, 'FlowProperty')
+        if (v := d.get('impactMethod')) is not None:
+            calculation_setup.impact_method = Ref.from_dict(v, 'ImpactMethod')
+        if (v := d.get('numberOfRuns')) is not None:
+            calculation_setup.number_of_runs = v
+        if (v := d.get('nwSet')) is not None:
+            calculation_setup.nw_set = Ref.from_dict(v, 'NwSet')
+        if (v := d.get('parameters')) is not None:
+            calculation_setup.parameters = [ParameterRedef.from_dict(e) for e in v]
+        if (v := d.get('target')) is not None:
+            calculation_setup.target = Ref.from_dict(v)
+        if (v := d.get('unit')) is not None:
+            calculation_setup.unit = Ref.from_dict(v, 'Unit')
+        if (v := d.get('withCosts')) is not None:
+            calculation_setup.with_costs = v
+        if (v := d.get('withRegionalization')) is not None:
+            calculation_setup.with_regionalization = v
+        return calculation_setup
+
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.allocation is not None:
+            _check_value(self.allocation, AllocationType, 'AllocationType', 'allocation', errors)
+        if self.amount is None:
+            errors.append('amount: value is required')
+        else:
+            _check_value(self.amount, (int, float), 'double', 'amount', errors)
+        if self.calculation_type is not None:
+            _check_value(self.calculation_type, CalculationType, 'CalculationType', 'calculation_type', errors)
+        if self.flow_property is not None:
+            _check_value(self.flow_property, Ref, 'Ref[FlowProperty]', 'flow_property', errors)
+        if self.impact_method is not None:
+            _check_value(self.impact_method, Ref, 'Ref[ImpactMethod]', 'impact_method', errors)
+        if self.number_of_runs is not None:
+            _check_value(self.number_of_runs, int, 'integer', 'number_of_runs', errors)
+        if self.nw_set is not None:
+            _check_value(self.nw_set, Ref, 'Ref[NwSet]', 'nw_set', errors)
+        if self.parameters is not None:
+            if _check_value(self.parameters, list, 'List[ParameterRedef]', 'parameters', errors):
+                for i, e in enumerate(self.parameters):
+                    _check_value(e, ParameterRedef, 'ParameterRedef', f'parameters[{i}]', errors)
+        if self.target is None:
+            errors.append('target: value is required')
+        else:
+            _check_value(self.target, Ref, 'Ref', 'target', errors)
+        if self.unit is not None:
+            _check_value(self.unit, Ref, 'Ref[Unit]', 'unit', errors)
+        if self.with_costs is not None:
+            _check_value(self.with_costs, bool, 'boolean', 'with_costs', errors)
+        if self.with_regionalization is not None:
+            _check_value(self.with_regionalization, bool, 'boolean', 'with_regionalization', errors)
+        return errors
 
 
 @dataclass
 class DQIndicator:
+    """An indicator of a data quality system ([DQSystem])."""
 
     name: Optional[str] = None
     position: Optional[int] = None
@@ -147,1017 +393,1782 @@ class DQIndicator:
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        if self.name:
+        if self.name is not None:
             d['name'] = self.name
-        if self.position:
+        if self.position is not None:
             d['position'] = self.position
-        if self.scores:
+        if self.scores is not None:
             d['scores'] = [e.to_dict() for e in self.scores]
         return d
 
     @staticmethod
     def from_dict(d: Dict[str, Any]) -> 'DQIndicator':
         d_q_indicator = DQIndicator()
-        if v := d.get('@type'):
-            d_q_indicator.schema_type = v
-        if v := d.get('name'):
+        if (v := d.get('name')) is not None:
             d_q_indicator.name = v
-        if v := d.get('position'):
+        if (v := d.get('position')) is not None:
             d_q_indicator.position = v
-        if v := d.get('scores'):
+        if (v := d.get('scores')) is not None:
             d_q_indicator.scores = [DQScore.from_dict(e) for e in v]
         return d_q_indicator
 
-
-@dataclass
-class ExchangeRef:
-
-    internal_id: Optional[int] = None
-
-    def to_dict(self) -> Dict[str, Any]:
-        d: Dict[str, Any] = {}
-        if self.internal_id:
-            d['internalId'] = self.internal_id
-        return d
-
-    @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'ExchangeRef':
-        exchange_ref = ExchangeRef()
-        if v := d.get('@type'):
-            exchange_ref.schema_type = v
-        if v := d.get('internalId'):
-            exchange_ref.internal_id = v
-        return exchange_ref
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.name is not None:
+            _check_value(self.name, str, 'string', 'name', errors)
+        if self.position is not None:
+            _check_value(self.position, int, 'int', 'position', errors)
+        if self.scores is not None:
+            if _check_value(self.scores, list, 'List[DQScore]', 'scores', errors):
+                for i, e in enumerate(self.scores):
+                    _check_value(e, DQScore, 'DQScore', f'scores[{i}]', errors)
+        return errors
 
 
 @dataclass
-class Ref:
+class DQScore:
+    """A score value of a data quality indicator."""
 
-    id: Optional[str] = None
-    category: Optional[str] = None
     description: Optional[str] = None
-    flow_type: Optional[FlowType] = None
-    library: Optional[str] = None
-    location: Optional[str] = None
-    name: Optional[str] = None
-    process_type: Optional[ProcessType] = None
-    ref_unit: Optional[str] = None
-    model_type: str = ''
+    label: Optional[str] = None
+    position: Optional[int] = None
+    uncertainty: Optional[float] = None
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        d['@type'] = self.model_type
-        if self.id:
-            d['@id'] = self.id
-        if self.category:
-            d['category'] = self.category
-        if self.description:
+        if self.description is not None:
             d['description'] = self.description
-        if self.flow_type:
-            d['flowType'] = self.flow_type.value
-        if self.library:
-            d['library'] = self.library
-        if self.location:
-            d['location'] = self.location
-        if self.name:
-            d['name'] = self.name
-        if self.process_type:
-            d['processType'] = self.process_type.value
-        if self.ref_unit:
-            d['refUnit'] = self.ref_unit
+        if self.label is not None:
+            d['label'] = self.label
+        if self.position is not None:
+            d['position'] = self.position
+        if self.uncertainty is not None:
+            d['uncertainty'] = self.uncertainty
         return d
 
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'Ref':
-        ref = Ref()
-        ref.model_type = d.get('@type', '')
-        if v := d.get('@type'):
-            ref.schema_type = v
-        if v := d.get('@id'):
-            ref.id = v
-        if v := d.get('category'):
-            ref.category = v
-        if v := d.get('description'):
-            ref.description = v
-        if v := d.get('flowType'):
-            ref.flow_type = v
-        if v := d.get('library'):
-            ref.library = v
-        if v := d.get('location'):
-            ref.location = v
-        if v := d.get('name'):
-            ref.name = v
-        if v := d.get('processType'):
-            ref.process_type = v
-        if v := d.get('refUnit'):
-            ref.ref_unit = v
-        return ref
+    def from_dict(d: Dict[str, Any]) -> 'DQScore':
+        d_q_score = DQScore()
+        if (v := d.get('description')) is not None:
+            d_q_score.description = v
+        if (v := d.get('label')) is not None:
+            d_q_score.label = v
+        if (v := d.get('position')) is not None:
+            d_q_score.position = v
+        if (v := d.get('uncertainty')) is not None:
+            d_q_score.uncertainty = v
+        return d_q_score
+
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.label is not None:
+            _check_value(self.label, str, 'string', 'label', errors)
+        if self.position is not None:
+            _check_value(self.position, int, 'int', 'position', errors)
+        if self.uncertainty is not None:
+            _check_value(self.uncertainty, (int, float), 'double', 'uncertainty', errors)
+        return errors
 
 
 @dataclass
-class Actor:
+class EpdModule:
+    """The results of an EPD are typically structured in modules."""
 
-    id: Optional[str] = None
-    address: Optional[str] = None
-    category: Optional[str] = None
-    city: Optional[str] = None
-    country: Optional[str] = None
-    description: Optional[str] = None
-    email: Optional[str] = None
-    last_change: Optional[str] = None
-    library: Optional[str] = None
     name: Optional[str] = None
-    tags: Optional[List[str]] = None
-    telefax: Optional[str] = None
-    telephone: Optional[str] = None
-    version: Optional[str] = None
-    website: Optional[str] = None
-    zip_code: Optional[str] = None
-
-    def __post_init__(self):
-        if self.id is None:
-            self.id = str(uuid.uuid4())
-        if self.version is None:
-            self.version = '01.00.000'
-        if self.last_change is None:
-            self.last_change = datetime.datetime.utcnow().isoformat() + 'Z'
+    """The name or identifier of the module, like A1."""
+    result: Optional[Ref[Result]] = None
+    """A reference to the module's result. Note that results are stand-alone
+    entities and that the same result could be referenced from different
+    modules. Also, results can be directly linked in product systems.
+    """
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        d['@type'] = 'Actor'
-        if self.id:
-            d['@id'] = self.id
-        if self.address:
-            d['address'] = self.address
-        if self.category:
-            d['category'] = self.category
-        if self.city:
-            d['city'] = self.city
-        if self.country:
-            d['country'] = self.country
-        if self.description:
-            d['description'] = self.description
-        if self.email:
-            d['email'] = self.email
-        if self.last_change:
-            d['lastChange'] = self.last_change
-        if self.library:
-            d['library'] = self.library
-        if self.name:
+        if self.name is not None:
             d['name'] = self.name
-        if self.tags:
-            d['tags'] = self.tags
-        if self.telefax:
-            d['telefax'] = self.telefax
-        if self.telephone:
-            d['telephone'] = self.telephone
-        if self.version:
-            d['version'] = self.version
-        if self.website:
-            d['website'] = self.website
-        if self.zip_code:
-            d['zipCode'] = self.zip_code
+        if self.result is not None:
+            d['result'] = self.result.to_dict()
         return d
 
-    def to_json(self) -> str:
-        return json.dumps(self.to_dict(), indent=2)
-
-    def to_ref(self) -> 'Ref':
-        ref = Ref(id=self.id, name=self.name)
-        ref.category = self.category
-        ref.model_type = 'Actor'
-        return ref
-
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'Actor':
-        actor = Actor()
-        if v := d.get('@type'):
-            actor.schema_type = v
-        if v := d.get('@id'):
-            actor.id = v
-        if v := d.get('address'):
-            actor.address = v
-        if v := d.get('category'):
-            actor.category = v
-        if v := d.get('city'):
-            actor.city = v
-        if v := d.get('country'):
-            actor.country = v
-        if v := d.get('description'):
-            actor.description = v
-        if v := d.get('email'):
-            actor.email = v
-        if v := d.get('lastChange'):
-            actor.last_change = v
-        if v := d.get('library'):
-            actor.library = v
-        if v := d.get('name'):
-            actor.name = v
-        if v := d.get('tags'):
-            actor.tags = v
-        if v := d.get('telefax'):
-            actor.telefax = v
-        if v := d.get('telephone'):
-            actor.telephone = v
-        if v := d.get('version'):
-            actor.version = v
-        if v := d.get('website'):
-            actor.website = v
-        if v := d.get('zipCode'):
-            actor.zip_code = v
-        return actor
+    def from_dict(d: Dict[str, Any]) -> 'EpdModule':
+        epd_module = EpdModule()
+        if (v := d.get('name')) is not None:
+            epd_module.name = v
+        if (v := d.get('result')) is not None:
+            epd_module.result = Ref.from_dict(v, 'Result')
+        return epd_module
 
-    @staticmethod
-    def from_json(data: Union[str, bytes]) -> 'Actor':
-        return Actor.from_dict(json.loads(data))
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.name is not None:
+            _check_value(self.name, str, 'string', 'name', errors)
+        if self.result is not None:
+            _check_value(self.result, Ref, 'Ref[Result]', 'result', errors)
+        return errors
 
 
 @dataclass
-class AllocationFactor:
+class EpdProduct:
+    """The declared product of an EPD."""
 
-    allocation_type: Optional[AllocationType] = None
-    exchange: Optional[ExchangeRef] = None
-    formula: Optional[str] = None
-    product: Optional[Ref] = None
-    value: Optional[float] = None
+    amount: Optional[float] = None
+    """The amount of the declared product."""
+    flow: Optional[Ref[Flow]] = None
+    """The reference to the product flow."""
+    flow_property: Optional[Ref[FlowProperty]] = None
+    """The reference to the flow property (quantity) in which the amount of the
+    declared product is given for the respective EPD. A missing flow
+    property reference means that the amount is given in the reference flow
+    property of the respective product flow.
+    """
+    unit: Optional[Ref[Unit]] = None
+    """The reference to the unit in which the amount of the declared product is
+    given for the respective EPD. A missing unit reference means that the
+    amount is given in the reference unit of the respective product flow.
+    """
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        if self.allocation_type:
-            d['allocationType'] = self.allocation_type.value
-        if self.exchange:
-            d['exchange'] = self.exchange.to_dict()
-        if self.formula:
-            d['formula'] = self.formula
-        if self.product:
-            d['product'] = self.product.to_dict()
-        if self.value:
-            d['value'] = self.value
+        if self.amount is not None:
+            d['amount'] = self.amount
+        if self.flow is not None:
+            d['flow'] = self.flow.to_dict()
+        if self.flow_property is not None:
+            d['flowProperty'] = self.flow_property.to_dict()
+        if self.unit is not None:
+            d['unit'] = self.unit.to_dict()
         return d
 
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'AllocationFactor':
-        allocation_factor = AllocationFactor()
-        if v := d.get('@type'):
-            allocation_factor.schema_type = v
-        if v := d.get('allocationType'):
-            allocation_factor.allocation_type = v
-        if v := d.get('exchange'):
-            allocation_factor.exchange = ExchangeRef.from_dict(v)
-        if v := d.get('formula'):
-            allocation_factor.formula = v
-        if v := d.get('product'):
-            allocation_factor.product = Ref[Flow].from_dict(v)
-        if v := d.get('value'):
-            allocation_factor.value = v
-        return allocation_factor
+    def from_dict(d: Dict[str, Any]) -> 'EpdProduct':
+        epd_product = EpdProduct()
+        if (v := d.get('amount')) is not None:
+            epd_product.amount = v
+        if (v := d.get('flow')) is not None:
+            epd_product.flow = Ref.from_dict(v, 'Flow')
+        if (v := d.get('flowProperty')) is not None:
+            epd_product.flow_property = Ref.from_dict(v, 'FlowProperty')
+        if (v := d.get('unit')) is not None:
+            epd_product.unit = Ref.from_dict(v, 'Unit')
+        return epd_product
+
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.amount is not None:
+            _check_value(self.amount, (int, float), 'double', 'amount', errors)
+        if self.flow is not None:
+            _check_value(self.flow, Ref, 'Ref[Flow]', 'flow', errors)
+        if self.flow_property is not None:
+            _check_value(self.flow_property, Ref, 'Ref[FlowProperty]', 'flow_property', errors)
+        if self.unit is not None:
+            _check_value(self.unit, Ref, 'Ref[Unit]', 'unit', errors)
+        return errors
 
 
 @dataclass
-class Category:
+class Exchange:
+    """An Exchange is an input or output of a [Flow] in a [Process]. The amount
+    of an exchange is given in a specific unit of a quantity
+    ([FlowProperty]) of the flow. The allowed units and flow properties that
+    can be used for a flow in an exchange are defined by the flow property
+    information in that flow (see also the [FlowPropertyFactor] type).
+    """
 
-    id: Optional[str] = None
-    category: Optional[str] = None
+    amount: Optional[float] = None
+    amount_formula: Optional[str] = None
+    base_uncertainty: Optional[float] = None
+    cost_formula: Optional[str] = None
+    """A formula for calculating the costs of this exchange."""
+    cost_value: Optional[float] = None
+    """The costs of this exchange."""
+    currency: Optional[Ref[Currency]] = None
+    """The currency in which the costs of this exchange are given."""
+    default_provider: Optional[Ref[Process]] = None
+    """A default provider is a [Process] that is linked as the provider of a
+    product input or the waste treatment provider of a waste output. It is
+    just an optional default setting which can be also ignored when building
+    product systems in openLCA. The user is always free to link processes in
+    product systems ignoring these defaults (but the flows and flow
+    directions have to match of course).
+    """
     description: Optional[str] = None
-    last_change: Optional[str] = None
-    library: Optional[str] = None
-    model_type: Optional[ModelType] = None
-    name: Optional[str] = None
-    tags: Optional[List[str]] = None
-    version: Optional[str] = None
-
-    def __post_init__(self):
-        if self.id is None:
-            self.id = str(uuid.uuid4())
-        if self.version is None:
-            self.version = '01.00.000'
-        if self.last_change is None:
-            self.last_change = datetime.datetime.utcnow().isoformat() + 'Z'
+    """A general comment about the input or output."""
+    dq_entry: Optional[str] = None
+    """A data quality entry like (1;3;2;5;1). The entry is a vector of data
+    quality values that need to match the data quality scheme for flow
+    inputs and outputs that is assigned to the [Process]. In such a scheme
+    the data quality indicators have fixed positions and the respective
+    values in the dqEntry vector map to these positions.
+    """
+    flow: Optional[Ref[Flow]] = None
+    """The reference to the flow of the exchange."""
+    flow_property: Optional[Ref[FlowProperty]] = None
+    """The quantity in which the amount is given."""
+    internal_id: Optional[int] = None
+    """The process internal ID of the exchange. This is used to identify
+    exchanges unambiguously within a process (e.g. when linking exchanges in
+    a product system where multiple exchanges with the same flow are
+    allowed). The value should be >= 1.
+    """
+    is_avoided_product: Optional[bool] = None
+    """Indicates whether this exchange is an avoided product."""
+    is_input: Optional[bool] = None
+    is_quantitative_reference: Optional[bool] = None
+    """Indicates whether the exchange is the quantitative reference of the
+    process.
+    """
+    location: Optional[Ref[Location]] = None
+    uncertainty: Optional[Uncertainty] = None
+    unit: Optional[Ref[Unit]] = None
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        d['@type'] = 'Category'
-        if self.id:
-            d['@id'] = self.id
-        if self.category:
-            d['category'] = self.category
-        if self.description:
+        if self.amount is not None:
+            d['amount'] = self.amount
+        if self.amount_formula is not None:
+            d['amountFormula'] = self.amount_formula
+        if self.base_uncertainty is not None:
+            d['baseUncertainty'] = self.base_uncertainty
+        if self.cost_formula is not None:
+            d['costFormula'] = self.cost_formula
+        if self.cost_value is not None:
+            d['costValue'] = self.cost_value
+        if self.currency is not None:
+            d['currency'] = self.currency.to_dict()
+        if self.default_provider is not None:
+            d['defaultProvider'] = self.default_provider.to_dict()
+        if self.description is not None:
             d['description'] = self.description
-        if self.last_change:
-            d['lastChange'] = self.last_change
-        if self.library:
-            d['library'] = self.library
-        if self.model_type:
-            d['modelType'] = self.model_type.value
-        if self.name:
-            d['name'] = self.name
-        if self.tags:
-            d['tags'] = self.tags
-        if self.version:
-            d['version'] = self.version
+        if self.dq_entry is not None:
+            d['dqEntry'] = self.dq_entry
+        if self.flow is not None:
+            d['flow'] = self.flow.to_dict()
+        if self.flow_property is not None:
+            d['flowProperty'] = self.flow_property.to_dict()
+        if self.internal_id is not None:
+            d['internalId'] = self.internal_id
+        if self.is_avoided_product is not None:
+            d['isAvoidedProduct'] = self.is_avoided_product
+        if self.is_input is not None:
+            d['isInput'] = self.is_input
+        if self.is_quantitative_reference is not None:
+            d['isQuantitativeReference'] = self.is_quantitative_reference
+        if self.location is not None:
+            d['location'] = self.location.to_dict()
+        if self.uncertainty is not None:
+            d['uncertainty'] = self.uncertainty.to_dict()
+        if self.unit is not None:
+            d['unit'] = self.unit.to_dict()
         return d
 
-    def to_json(self) -> str:
-        return json.dumps(self.to_dict(), indent=2)
+    @staticmethod
+    def from_dict(d: Dict[str, Any]) -> 'Exchange':
+        exchange = Exchange()
+        if (v := d.get('amount')) is not None:
+            exchange.amount = v
+        if (v := d.get('amountFormula')) is not None:
+            exchange.amount_formula = v
+        if (v := d.get('baseUncertainty')) is not None:
+            exchange.base_uncertainty = v
+        if (v := d.get('costFormula')) is not None:
+            exchange.cost_formula = v
+        if (v := d.get('costValue')) is not None:
+            exchange.cost_value = v
+        if (v := d.get('currency')) is not None:
+            exchange.currency = Ref.from_dict(v, 'Currency')
+        if (v := d.get('defaultProvider')) is not None:
+            exchange.default_provider = Ref.from_dict(v, 'Process')
+        if (v := d.get('description')) is not None:
+            exchange.description = v
+        if (v := d.get('dqEntry')) is not None:
+            exchange.dq_entry = v
+        if (v := d.get('flow')) is not None:
+            exchange.flow = Ref.from_dict(v, 'Flow')
+        if (v := d.get('flowProperty')) is not None:
+            exchange.flow_property = Ref.from_dict(v, 'FlowProperty')
+        if (v := d.get('internalId')) is not None:
+            exchange.internal_id = v
+        if (v := d.get('isAvoidedProduct')) is not None:
+            exchange.is_avoided_product = v
+        if (v := d.get('isInput')) is not None:
+            exchange.is_input = v
+        if (v := d.get('isQuantitativeReference')) is not None:
+            exchange.is_quantitative_reference = v
+        if (v := d.get('location')) is not None:
+            exchange.location = Ref.from_dict(v, 'Location')
+        if (v := d.get('uncertainty')) is not None:
+            exchange.uncertainty = Uncertainty.from_dict(v)
+        if (v := d.get('unit')) is not None:
+            exchange.unit = Ref.from_dict(v, 'Unit')
+        return exchange
 
-    def to_ref(self) -> 'Ref':
-        ref = Ref(id=self.id, name=self.name)
-        ref.category = self.category
-        ref.model_type = 'Category'
-        return ref
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.amount is not None:
+            _check_value(self.amount, (int, float), 'double', 'amount', errors)
+        if self.amount_formula is not None:
+            _check_value(self.amount_formula, str, 'string', 'amount_formula', errors)
+        if self.base_uncertainty is not None:
+            _check_value(self.base_uncertainty, (int, float), 'double', 'base_uncertainty', errors)
+        if self.cost_formula is not None:
+            _check_value(self.cost_formula, str, 'string', 'cost_formula', errors)
+        if self.cost_value is not None:
+            _check_value(self.cost_value, (int, float), 'double', 'cost_value', errors)
+        if self.currency is not None:
+            _check_value(self.currency, Ref, 'Ref[Currency]', 'currency', errors)
+        if self.default_provider is not None:
+            _check_value(self.default_provider, Ref, 'Ref[Process]', 'default_provider', errors)
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.dq_entry is not None:
+            _check_value(self.dq_entry, str, 'string', 'dq_entry', errors)
+        if self.flow is not None:
+            _check_value(self.flow, Ref, 'Ref[Flow]', 'flow', errors)
+        if self.flow_property is not None:
+            _check_value(self.flow_property, Ref, 'Ref[FlowProperty]', 'flow_property', errors)
+        if self.internal_id is not None:
+            _check_value(self.internal_id, int, 'int', 'internal_id', errors)
+        if self.is_avoided_product is not None:
+            _check_value(self.is_avoided_product, bool, 'boolean', 'is_avoided_product', errors)
+        if self.is_input is not None:
+            _check_value(self.is_input, bool, 'boolean', 'is_input', errors)
+        if self.is_quantitative_reference is not None:
+            _check_value(self.is_quantitative_reference, bool, 'boolean', 'is_quantitative_reference', errors)
+        if self.location is not None:
+            _check_value(self.location, Ref, 'Ref[Location]', 'location', errors)
+        if self.uncertainty is not None:
+            _check_value(self.uncertainty, Uncertainty, 'Uncertainty', 'uncertainty', errors)
+        if self.unit is not None:
+            _check_value(self.unit, Ref, 'Ref[Unit]', 'unit', errors)
+        return errors
 
-    @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'Category':
-        category = Category()
-        if v := d.get('@type'):
-            category.schema_type = v
-        if v := d.get('@id'):
-            category.id = v
-        if v := d.get('category'):
-            category.category = v
-        if v := d.get('description'):
-            category.description = v
-        if v := d.get('lastChange'):
-            category.last_change = v
-        if v := d.get('library'):
-            category.library = v
-        if v := d.get('modelType'):
-            category.model_type = v
-        if v := d.get('name'):
-            category.name = v
-        if v := d.get('tags'):
-            category.tags = v
-        if v := d.get('version'):
-            category.version = v
-        return category
+
+@dataclass
+class ExchangeRef:
+    """An instance of this class describes a reference to an exchange in a
+    process. When we reference such an exchange we only need the information
+    to indentify that exchange unambiguously in a process.
+    """
+
+    internal_id: Optional[int] = None
+    """The internal ID of the exchange."""
+
+    def to_dict(self) -> Dict[str, Any]:
+        d: Dict[str, Any] = {}
+        if self.internal_id is not None:
+            d['internalId'] = self.internal_id
+        return d
 
     @staticmethod
-    def from_json(data: Union[str, bytes]) -> 'Category':
-        return Category.from_dict(json.loads(data))
+    def from_dict(d: Dict[str, Any]) -> 'ExchangeRef':
+        exchange_ref = ExchangeRef()
+        if (v := d.get('internalId')) is not None:
+            exchange_ref.internal_id = v
+        return exchange_ref
+
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.internal_id is None:
+            errors.append('internal_id: value is required')
+        else:
+            _check_value(self.internal_id, int, 'int', 'internal_id', errors)
+        return errors
 
 
 @dataclass
-class Currency:
+class FlowMapEntry:
+    """A mapping from a source flow to a target flow."""
 
-    id: Optional[str] = None
-    category: Optional[str] = None
-    code: Optional[str] = None
     conversion_factor: Optional[float] = None
-    description: Optional[str] = None
-    last_change: Optional[str] = None
-    library: Optional[str] = None
-    name: Optional[str] = None
-    ref_currency: Optional[Ref] = None
-    tags: Optional[List[str]] = None
-    version: Optional[str] = None
-
-    def __post_init__(self):
-        if self.id is None:
-            self.id = str(uuid.uuid4())
-        if self.version is None:
-            self.version = '01.00.000'
-        if self.last_change is None:
-            self.last_change = datetime.datetime.utcnow().isoformat() + 'Z'
+    """The conversion factor to convert the amount of 1 unit of the source flow
+    into the corresponding quantity of the target flow.
+    """
+    from_: Optional[FlowMapRef] = None
+    """Describes the source flow of the mapping."""
+    to: Optional[FlowMapRef] = None
+    """Describes the target of the mapping."""
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        d['@type'] = 'Currency'
-        if self.id:
-            d['@id'] = self.id
-        if self.category:
-            d['category'] = self.category
-        if self.code:
-            d['code'] = self.code
-        if self.conversion_factor:
+        if self.conversion_factor is not None:
             d['conversionFactor'] = self.conversion_factor
-        if self.description:
-            d['description'] = self.description
-        if self.last_change:
-            d['lastChange'] = self.last_change
-        if self.library:
-            d['library'] = self.library
-        if self.name:
-            d['name'] = self.name
-        if self.ref_currency:
-            d['refCurrency'] = self.ref_currency.to_dict()
-        if self.tags:
-            d['tags'] = self.tags
-        if self.version:
-            d['version'] = self.version
+        if self.from_ is not None:
+            d['from'] = self.from_.to_dict()
+        if self.to is not None:
+            d['to'] = self.to.to_dict()
         return d
 
-    def to_json(self) -> str:
-        return json.dumps(self.to_dict(), indent=2)
-
-    def to_ref(self) -> 'Ref':
-        ref = Ref(id=self.id, name=self.name)
-        ref.category = self.category
-        ref.model_type = 'Currency'
-        return ref
-
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'Currency':
-        currency = Currency()
-        if v := d.get('@type'):
-            currency.schema_type = v
-        if v := d.get('@id'):
-            currency.id = v
-        if v := d.get('category'):
-            currency.category = v
-        if v := d.get('code'):
-            currency.code = v
-        if v := d.get('conversionFactor'):
-            currency.conversion_factor = v
-        if v := d.get('description'):
-            currency.description = v
-        if v := d.get('lastChange'):
-            currency.last_change = v
-        if v := d.get('library'):
-            currency.library = v
-        if v := d.get('name'):
-            currency.name = v
-        if v := d.get('refCurrency'):
-            currency.ref_currency = Ref[Currency].from_dict(v)
-        if v := d.get('tags'):
-            currency.tags = v
-        if v := d.get('version'):
-            currency.version = v
-        return currency
+    def from_dict(d: Dict[str, Any]) -> 'FlowMapEntry':
+        flow_map_entry = FlowMapEntry()
+        if (v := d.get('conversionFactor')) is not None:
+            flow_map_entry.conversion_factor = v
+        if (v := d.get('from')) is not None:
+            flow_map_entry.from_ = FlowMapRef.from_dict(v)
+        if (v := d.get('to')) is not None:
+            flow_map_entry.to = FlowMapRef.from_dict(v)
+        return flow_map_entry
 
-    @staticmethod
-    def from_json(data: Union[str, bytes]) -> 'Currency':
-        return Currency.from_dict(json.loads(data))
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.conversion_factor is not None:
+            _check_value(self.conversion_factor, (int, float), 'double', 'conversion_factor', errors)
+        if self.from_ is not None:
+            _check_value(self.from_, FlowMapRef, 'FlowMapRef', 'from_', errors)
+        if self.to is not None:
+            _check_value(self.to, FlowMapRef, 'FlowMapRef', 'to', errors)
+        return errors
 
 
 @dataclass
-class DQSystem:
-
-    id: Optional[str] = None
-    category: Optional[str] = None
-    description: Optional[str] = None
-    has_uncertainties: Optional[bool] = None
-    indicators: Optional[List[DQIndicator]] = None
-    last_change: Optional[str] = None
-    library: Optional[str] = None
-    name: Optional[str] = None
-    source: Optional[Ref] = None
-    tags: Optional[List[str]] = None
-    version: Optional[str] = None
+class FlowMapRef:
+    """A flow reference in a flow mapping."""
 
-    def __post_init__(self):
-        if self.id is None:
-            self.id = str(uuid.uuid4())
-        if self.version is None:
-            self.version = '01.00.000'
-        if self.last_change is None:
-            self.last_change = datetime.datetime.utcnow().isoformat() + 'Z'
+    flow: Optional[Ref[Flow]] = None
+    flow_property: Optional[Ref[FlowProperty]] = None
+    provider: Optional[Ref[Process]] = None
+    unit: Optional[Ref[Unit]] = None
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        d['@type'] = 'DQSystem'
-        if self.id:
-            d['@id'] = self.id
-        if self.category:
-            d['category'] = self.category
-        if self.description:
-            d['description'] = self.description
-        if self.has_uncertainties:
-            d['hasUncertainties'] = self.has_uncertainties
-        if self.indicators:
-            d['indicators'] = [e.to_dict() for e in self.indicators]
-        if self.last_change:
-            d['lastChange'] = self.last_change
-        if self.library:
-            d['library'] = self.library
-        if self.name:
-            d['name'] = self.name
-        if self.source:
-            d['source'] = self.source.to_dict()
-        if self.tags:
-            d['tags'] = self.tags
-        if self.version:
-            d['version'] = self.version
+        if self.flow is not None:
+            d['flow'] = self.flow.to_dict()
+        if self.flow_property is not None:
+            d['flowProperty'] = self.flow_property.to_dict()
+        if self.provider is not None:
+            d['provider'] = self.provider.to_dict()
+        if self.unit is not None:
+            d['unit'] = self.unit.to_dict()
         return d
 
-    def to_json(self) -> str:
-        return json.dumps(self.to_dict(), indent=2)
-
-    def to_ref(self) -> 'Ref':
-        ref = Ref(id=self.id, name=self.name)
-        ref.category = self.category
-        ref.model_type = 'DQSystem'
-        return ref
-
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'DQSystem':
-        d_q_system = DQSystem()
-        if v := d.get('@type'):
-            d_q_system.schema_type = v
-        if v := d.get('@id'):
-            d_q_system.id = v
-        if v := d.get('category'):
-            d_q_system.category = v
-        if v := d.get('description'):
-            d_q_system.description = v
-        if v := d.get('hasUncertainties'):
-            d_q_system.has_uncertainties = v
-        if v := d.get('indicators'):
-            d_q_system.indicators = [DQIndicator.from_dict(e) for e in v]
-        if v := d.get('lastChange'):
-            d_q_system.last_change = v
-        if v := d.get('library'):
-            d_q_system.library = v
-        if v := d.get('name'):
-            d_q_system.name = v
-        if v := d.get('source'):
-            d_q_system.source = Ref[Source].from_dict(v)
-        if v := d.get('tags'):
-            d_q_system.tags = v
-        if v := d.get('version'):
-            d_q_system.version = v
-        return d_q_system
+    def from_dict(d: Dict[str, Any]) -> 'FlowMapRef':
+        flow_map_ref = FlowMapRef()
+        if (v := d.get('flow')) is not None:
+            flow_map_ref.flow = Ref.from_dict(v, 'Flow')
+        if (v := d.get('flowProperty')) is not None:
+            flow_map_ref.flow_property = Ref.from_dict(v, 'FlowProperty')
+        if (v := d.get('provider')) is not None:
+            flow_map_ref.provider = Ref.from_dict(v, 'Process')
+        if (v := d.get('unit')) is not None:
+            flow_map_ref.unit = Ref.from_dict(v, 'Unit')
+        return flow_map_ref
 
-    @staticmethod
-    def from_json(data: Union[str, bytes]) -> 'DQSystem':
-        return DQSystem.from_dict(json.loads(data))
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.flow is not None:
+            _check_value(self.flow, Ref, 'Ref[Flow]', 'flow', errors)
+        if self.flow_property is not None:
+            _check_value(self.flow_property, Ref, 'Ref[FlowProperty]', 'flow_property', errors)
+        if self.provider is not None:
+            _check_value(self.provider, Ref, 'Ref[Process]', 'provider', errors)
+        if self.unit is not None:
+            _check_value(self.unit, Ref, 'Ref[Unit]', 'unit', errors)
+        return errors
 
 
 @dataclass
-class EpdModule:
+class FlowPropertyFactor:
+    """A FlowPropertyFactor is a conversion factor between flow properties
+    (quantities) of a flow. As an example the amount of the flow 'water' in
+    a process could be expressed in 'kg' mass or 'm3' volume. In this case
+    the flow water would have two flow property factors: one for the flow
+    property 'mass' and one for 'volume'. Each of these flow properties has
+    a reference to a unit group which again has a reference unit. In the
+    example the flow property 'mass' could reference the unit group 'units
+    of mass' with 'kg' as reference unit and volume could reference the unit
+    group 'units of volume' with 'm3' as reference unit. The flow property
+    factor is now the conversion factor between these two reference units
+    where the factor of the reference flow property of the flow is 1. If the
+    reference flow property of 'water' in the example would be 'mass' the
+    respective flow property factor would be 1 and the factor for 'volume'
+    would be 0.001 (as 1 kg water is 0.001 m3). The amount of water in a
+    process can now be also given in liter, tons, grams etc. For this, the
+    unit conversion factor of the respective unit group can be used to
+    convert into the reference unit (which then can be used to convert to
+    the reference unit of another flow property). Another thing to note is
+    that different flow properties can refer to the same unit group (e.g. MJ
+    upper calorific value and MJ lower calorific value.)
+    """
 
-    name: Optional[str] = None
-    result: Optional[Ref] = None
+    conversion_factor: Optional[float] = None
+    """The value of the conversion factor."""
+    flow_property: Optional[Ref[FlowProperty]] = None
+    """The flow property (quantity) of the factor."""
+    is_ref_flow_property: Optional[bool] = None
+    """Indicates whether the flow property of the factor is the reference flow
+    property of the flow. The reference flow property must have a conversion
+    factor of 1.0 and there should be only one reference flow property.
+    """
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        if self.name:
-            d['name'] = self.name
-        if self.result:
-            d['result'] = self.result.to_dict()
+        if self.conversion_factor is not None:
+            d['conversionFactor'] = self.conversion_factor
+        if self.flow_property is not None:
+            d['flowProperty'] = self.flow_property.to_dict()
+        if self.is_ref_flow_property is not None:
+            d['isRefFlowProperty'] = self.is_ref_flow_property
         return d
 
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'EpdModule':
-        epd_module = EpdModule()
-        if v := d.get('@type'):
-            epd_module.schema_type = v
-        if v := d.get('name'):
-            epd_module.name = v
-        if v := d.get('result'):
-            epd_module.result = Ref[Result].from_dict(v)
-        return epd_module
+    def from_dict(d: Dict[str, Any]) -> 'FlowPropertyFactor':
+        flow_property_factor = FlowPropertyFactor()
+        if (v := d.get('conversionFactor')) is not None:
+            flow_property_factor.conversion_factor = v
+        if (v := d.get('flowProperty')) is not None:
+            flow_property_factor.flow_property = Ref.from_dict(v, 'FlowProperty')
+        if (v := d.get('isRefFlowProperty')) is not None:
+            flow_property_factor.is_ref_flow_property = v
+        return flow_property_factor
+
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.conversion_factor is not None:
+            _check_value(self.conversion_factor, (int, float), 'double', 'conversion_factor', errors)
+        if self.flow_property is not None:
+            _check_value(self.flow_property, Ref, 'Ref[FlowProperty]', 'flow_property', errors)
+        if self.is_ref_flow_property is not None:
+            _check_value(self.is_ref_flow_property, bool, 'boolean', 'is_ref_flow_property', errors)
+        return errors
 
 
 @dataclass
-class EpdProduct:
+class FlowResult:
+    """A calculation result of a flow."""
 
     amount: Optional[float] = None
-    flow: Optional[Ref] = None
-    flow_property: Optional[Ref] = None
-    unit: Optional[Ref] = None
+    description: Optional[str] = None
+    flow: Optional[Ref[Flow]] = None
+    """The flow reference."""
+    flow_property: Optional[Ref[FlowProperty]] = None
+    """The flow property in which the amount of the result is given. If
+    missing, the amount is expected to be given in the reference flow
+    property of the flow.
+    """
+    is_input: Optional[bool] = None
+    """Indicates whether the flow is an input or not."""
+    is_ref_flow: Optional[bool] = None
+    """true if this is the quantitative reference flow of the result."""
+    location: Optional[Ref[Location]] = None
+    """The location of this flow result in case of a regionalized result."""
+    unit: Optional[Ref[Unit]] = None
+    """The unit in which the amount of the result is given. If missing, the
+    amount is expected to be given in the reference unit of the flow.
+    """
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        if self.amount:
+        if self.amount is not None:
             d['amount'] = self.amount
-        if self.flow:
+        if self.description is not None:
+            d['description'] = self.description
+        if self.flow is not None:
             d['flow'] = self.flow.to_dict()
-        if self.flow_property:
+        if self.flow_property is not None:
             d['flowProperty'] = self.flow_property.to_dict()
-        if self.unit:
+        if self.is_input is not None:
+            d['isInput'] = self.is_input
+        if self.is_ref_flow is not None:
+            d['isRefFlow'] = self.is_ref_flow
+        if self.location is not None:
+            d['location'] = self.location.to_dict()
+        if self.unit is not None:
             d['unit'] = self.unit.to_dict()
         return d
 
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'EpdProduct':
-        epd_product = EpdProduct()
-        if v := d.get('@type'):
-            epd_product.schema_type = v
-        if v := d.get('amount'):
-            epd_product.amount = v
-        if v := d.get('flow'):
-            epd_product.flow = Ref[Flow].from_dict(v)
-        if v := d.get('flowProperty'):
-            epd_product.flow_property = Ref[FlowProperty].from_dict(v)
-        if v := d.get('unit'):
-            epd_product.unit = Ref[Unit].from_dict(v)
-        return epd_product
+    def from_dict(d: Dict[str, Any]) -> 'FlowResult':
+        flow_result = FlowResult()
+        if (v := d.get('amount')) is not None:
+            flow_result.amount = v
+        if (v := d.get('description')) is not None:
+            flow_result.description = v
+        if (v := d.get('flow')) is not None:
+            flow_result.flow = Ref.from_dict(v, 'Flow')
+        if (v := d.get('flowProperty')) is not None:
+            flow_result.flow_property = Ref.from_dict(v, 'FlowProperty')
+        if (v := d.get('isInput')) is not None:
+            flow_result.is_input = v
+        if (v := d.get('isRefFlow')) is not None:
+            flow_result.is_ref_flow = v
+        if (v := d.get('location')) is not None:
+            flow_result.location = Ref.from_dict(v, 'Location')
+        if (v := d.get('unit')) is not None:
+            flow_result.unit = Ref.from_dict(v, 'Unit')
+        return flow_result
+
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.amount is not None:
+            _check_value(self.amount, (int, float), 'double', 'amount', errors)
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.flow is not None:
+            _check_value(self.flow, Ref, 'Ref[Flow]', 'flow', errors)
+        if self.flow_property is not None:
+            _check_value(self.flow_property, Ref, 'Ref[FlowProperty]', 'flow_property', errors)
+        if self.is_input is not None:
+            _check_value(self.is_input, bool, 'boolean', 'is_input', errors)
+        if self.is_ref_flow is not None:
+            _check_value(self.is_ref_flow, bool, 'boolean', 'is_ref_flow', errors)
+        if self.location is not None:
+            _check_value(self.location, Ref, 'Ref[Location]', 'location', errors)
+        if self.unit is not None:
+            _check_value(self.unit, Ref, 'Ref[Unit]', 'unit', errors)
+        return errors
 
 
 @dataclass
-class Epd:
-
-    id: Optional[str] = None
-    category: Optional[str] = None
-    description: Optional[str] = None
-    last_change: Optional[str] = None
-    library: Optional[str] = None
-    manufacturer: Optional[Ref] = None
-    modules: Optional[List[EpdModule]] = None
-    name: Optional[str] = None
-    pcr: Optional[Ref] = None
-    product: Optional[EpdProduct] = None
-    program_operator: Optional[Ref] = None
-    tags: Optional[List[str]] = None
-    urn: Optional[str] = None
-    verifier: Optional[Ref] = None
-    version: Optional[str] = None
-
-    def __post_init__(self):
-        if self.id is None:
-            self.id = str(uuid.uuid4())
-        if self.version is None:
-            self.version = '01.00.000'
-        if self.last_change is None:
-            self.last_change = datetime.datetime.utcnow().isoformat() + 'Z'
+class ImpactFactor:
+    """A single characterisation factor of a LCIA category for a flow."""
+
+    flow: Optional[Ref[Flow]] = None
+    """The [Flow] of the impact assessment factor."""
+    flow_property: Optional[Ref[FlowProperty]] = None
+    """The quantity of the flow to which the LCIA factor is related (e.g.
+    Mass).
+    """
+    formula: Optional[str] = None
+    """A mathematical formula for calculating the value of the LCIA factor."""
+    location: Optional[Ref[Location]] = None
+    """In case of a regionalized impact category, this field can contain the
+    location for which this factor is valid.
+    """
+    uncertainty: Optional[Uncertainty] = None
+    """The uncertainty distribution of the factors' value."""
+    unit: Optional[Ref[Unit]] = None
+    """The flow unit to which the LCIA factor is related (e.g. kg)."""
+    value: Optional[float] = None
+    """The value of the impact assessment factor."""
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        d['@type'] = 'Epd'
-        if self.id:
-            d['@id'] = self.id
-        if self.category:
-            d['category'] = self.category
-        if self.description:
-            d['description'] = self.description
-        if self.last_change:
-            d['lastChange'] = self.last_change
-        if self.library:
-            d['library'] = self.library
-        if self.manufacturer:
-            d['manufacturer'] = self.manufacturer.to_dict()
-        if self.modules:
-            d['modules'] = [e.to_dict() for e in self.modules]
-        if self.name:
-            d['name'] = self.name
-        if self.pcr:
-            d['pcr'] = self.pcr.to_dict()
-        if self.product:
-            d['product'] = self.product.to_dict()
-        if self.program_operator:
-            d['programOperator'] = self.program_operator.to_dict()
-        if self.tags:
-            d['tags'] = self.tags
-        if self.urn:
-            d['urn'] = self.urn
-        if self.verifier:
-            d['verifier'] = self.verifier.to_dict()
-        if self.version:
-            d['version'] = self.version
+        if self.flow is not None:
+            d['flow'] = self.flow.to_dict()
+        if self.flow_property is not None:
+            d['flowProperty'] = self.flow_property.to_dict()
+        if self.formula is not None:
+            d['formula'] = self.formula
+        if self.location is not None:
+            d['location'] = self.location.to_dict()
+        if self.uncertainty is not None:
+            d['uncertainty'] = self.uncertainty.to_dict()
+        if self.unit is not None:
+            d['unit'] = self.unit.to_dict()
+        if self.value is not None:
+            d['value'] = self.value
         return d
 
-    def to_json(self) -> str:
-        return json.dumps(self.to_dict(), indent=2)
-
-    def to_ref(self) -> 'Ref':
-        ref = Ref(id=self.id, name=self.name)
-        ref.category = self.category
-        ref.model_type = 'Epd'
-        return ref
-
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'Epd':
-        epd = Epd()
-        if v := d.get('@type'):
-            epd.schema_type = v
-        if v := d.get('@id'):
-            epd.id = v
-        if v := d.get('category'):
-            epd.category = v
-        if v := d.get('description'):
-            epd.description = v
-        if v := d.get('lastChange'):
-            epd.last_change = v
-        if v := d.get('library'):
-            epd.library = v
-        if v := d.get('manufacturer'):
-            epd.manufacturer = Ref[Actor].from_dict(v)
-        if v := d.get('modules'):
-            epd.modules = [EpdModule.from_dict(e) for e in v]
-        if v := d.get('name'):
-            epd.name = v
-        if v := d.get('pcr'):
-            epd.pcr = Ref[Source].from_dict(v)
-        if v := d.get('product'):
-            epd.product = EpdProduct.from_dict(v)
-        if v := d.get('programOperator'):
-            epd.program_operator = Ref[Actor].from_dict(v)
-        if v := d.get('tags'):
-            epd.tags = v
-        if v := d.get('urn'):
-            epd.urn = v
-        if v := d.get('verifier'):
-            epd.verifier = Ref[Actor].from_dict(v)
-        if v := d.get('version'):
-            epd.version = v
-        return epd
+    def from_dict(d: Dict[str, Any]) -> 'ImpactFactor':
+        impact_factor = ImpactFactor()
+        if (v := d.get('flow')) is not None:
+            impact_factor.flow = Ref.from_dict(v, 'Flow')
+        if (v := d.get('flowProperty')) is not None:
+            impact_factor.flow_property = Ref.from_dict(v, 'FlowProperty')
+        if (v := d.get('formula')) is not None:
+            impact_factor.formula = v
+        if (v := d.get('location')) is not None:
+            impact_factor.location = Ref.from_dict(v, 'Location')
+        if (v := d.get('uncertainty')) is not None:
+            impact_factor.uncertainty = Uncertainty.from_dict(v)
+        if (v := d.get('unit')) is not None:
+            impact_factor.unit = Ref.from_dict(v, 'Unit')
+        if (v := d.get('value')) is not None:
+            impact_factor.value = v
+        return impact_factor
 
-    @staticmethod
-    def from_json(data: Union[str, bytes]) -> 'Epd':
-        return Epd.from_dict(json.loads(data))
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.flow is not None:
+            _check_value(self.flow, Ref, 'Ref[Flow]', 'flow', errors)
+        if self.flow_property is not None:
+            _check_value(self.flow_property, Ref, 'Ref[FlowProperty]', 'flow_property', errors)
+        if self.formula is not None:
+            _check_value(self.formula, str, 'string', 'formula', errors)
+        if self.location is not None:
+            _check_value(self.location, Ref, 'Ref[Location]', 'location', errors)
+        if self.uncertainty is not None:
+            _check_value(self.uncertainty, Uncertainty, 'Uncertainty', 'uncertainty', errors)
+        if self.unit is not None:
+            _check_value(self.unit, Ref, 'Ref[Unit]', 'unit', errors)
+        if self.value is not None:
+            _check_value(self.value, (int, float), 'double', 'value', errors)
+        return errors
 
 
 @dataclass
-class FlowMapRef:
+class ImpactResult:
+    """A calculation result of an impact assessment category."""
 
-    flow: Optional[Ref] = None
-    flow_property: Optional[Ref] = None
-    provider: Optional[Ref] = None
-    unit: Optional[Ref] = None
+    amount: Optional[float] = None
+    """The value the result."""
+    description: Optional[str] = None
+    indicator: Optional[Ref[ImpactCategory]] = None
+    """The impact assessment category."""
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        if self.flow:
-            d['flow'] = self.flow.to_dict()
-        if self.flow_property:
-            d['flowProperty'] = self.flow_property.to_dict()
-        if self.provider:
-            d['provider'] = self.provider.to_dict()
-        if self.unit:
-            d['unit'] = self.unit.to_dict()
+        if self.amount is not None:
+            d['amount'] = self.amount
+        if self.description is not None:
+            d['description'] = self.description
+        if self.indicator is not None:
+            d['indicator'] = self.indicator.to_dict()
         return d
 
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'FlowMapRef':
-        flow_map_ref = FlowMapRef()
-        if v := d.get('@type'):
-            flow_map_ref.schema_type = v
-        if v := d.get('flow'):
-            flow_map_ref.flow = Ref[Flow].from_dict(v)
-        if v := d.get('flowProperty'):
-            flow_map_ref.flow_property = Ref[FlowProperty].from_dict(v)
-        if v := d.get('provider'):
-            flow_map_ref.provider = Ref[Process].from_dict(v)
-        if v := d.get('unit'):
-            flow_map_ref.unit = Ref[Unit].from_dict(v)
-        return flow_map_ref
+    def from_dict(d: Dict[str, Any]) -> 'ImpactResult':
+        impact_result = ImpactResult()
+        if (v := d.get('amount')) is not None:
+            impact_result.amount = v
+        if (v := d.get('description')) is not None:
+            impact_result.description = v
+        if (v := d.get('indicator')) is not None:
+            impact_result.indicator = Ref.from_dict(v, 'ImpactCategory')
+        return impact_result
+
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.amount is not None:
+            _check_value(self.amount, (int, float), 'double', 'amount', errors)
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.indicator is not None:
+            _check_value(self.indicator, Ref, 'Ref[ImpactCategory]', 'indicator', errors)
+        return errors
 
 
 @dataclass
-class FlowMapEntry:
+class NwFactor:
+    """A normalization and weighting factor of a [NwSet] related to an impact
+    category. Depending on the purpose of the [NwSet] (normalization,
+    weighting, or both) the normalization and weighting factor can be
+    present or not.
+    """
 
-    conversion_factor: Optional[float] = None
-    from_: Optional[FlowMapRef] = None
-    to: Optional[FlowMapRef] = None
+    impact_category: Optional[Ref[ImpactCategory]] = None
+    normalisation_factor: Optional[float] = None
+    weighting_factor: Optional[float] = None
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        if self.conversion_factor:
-            d['conversionFactor'] = self.conversion_factor
-        if self.from_:
-            d['from'] = self.from_.to_dict()
-        if self.to:
-            d['to'] = self.to.to_dict()
+        if self.impact_category is not None:
+            d['impactCategory'] = self.impact_category.to_dict()
+        if self.normalisation_factor is not None:
+            d['normalisationFactor'] = self.normalisation_factor
+        if self.weighting_factor is not None:
+            d['weightingFactor'] = self.weighting_factor
         return d
 
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'FlowMapEntry':
-        flow_map_entry = FlowMapEntry()
-        if v := d.get('@type'):
-            flow_map_entry.schema_type = v
-        if v := d.get('conversionFactor'):
-            flow_map_entry.conversion_factor = v
-        if v := d.get('from'):
-            flow_map_entry.from_ = FlowMapRef.from_dict(v)
-        if v := d.get('to'):
-            flow_map_entry.to = FlowMapRef.from_dict(v)
-        return flow_map_entry
+    def from_dict(d: Dict[str, Any]) -> 'NwFactor':
+        nw_factor = NwFactor()
+        if (v := d.get('impactCategory')) is not None:
+            nw_factor.impact_category = Ref.from_dict(v, 'ImpactCategory')
+        if (v := d.get('normalisationFactor')) is not None:
+            nw_factor.normalisation_factor = v
+        if (v := d.get('weightingFactor')) is not None:
+            nw_factor.weighting_factor = v
+        return nw_factor
+
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.impact_category is not None:
+            _check_value(self.impact_category, Ref, 'Ref[ImpactCategory]', 'impact_category', errors)
+        if self.normalisation_factor is not None:
+            _check_value(self.normalisation_factor, (int, float), 'double', 'normalisation_factor', errors)
+        if self.weighting_factor is not None:
+            _check_value(self.weighting_factor, (int, float), 'double', 'weighting_factor', errors)
+        return errors
 
 
 @dataclass
-class FlowMap:
+class NwSet:
+    """A normalization and weighting set."""
 
     id: Optional[str] = None
-    category: Optional[str] = None
+    """The reference ID (or UUID) of this entity."""
     description: Optional[str] = None
-    last_change: Optional[str] = None
-    library: Optional[str] = None
-    mappings: Optional[List[FlowMapEntry]] = None
+    """The description of the entity."""
+    factors: Optional[List[NwFactor]] = None
+    """The list of normalization and weighting factors of this set."""
     name: Optional[str] = None
-    source: Optional[Ref] = None
-    tags: Optional[List[str]] = None
-    target: Optional[Ref] = None
-    version: Optional[str] = None
-
-    def __post_init__(self):
-        if self.id is None:
-            self.id = str(uuid.uuid4())
-        if self.version is None:
-            self.version = '01.00.000'
-        if self.last_change is None:
-            self.last_change = datetime.datetime.utcnow().isoformat() + 'Z'
+    """The name of the entity."""
+    weighted_score_unit: Optional[str] = None
+    """This is the optional unit of the (normalized and) weighted score when
+    this normalization and weighting set was applied on a LCIA result.
+    """
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        d['@type'] = 'FlowMap'
-        if self.id:
+        if self.id is not None:
             d['@id'] = self.id
-        if self.category:
-            d['category'] = self.category
-        if self.description:
+        if self.description is not None:
             d['description'] = self.description
-        if self.last_change:
-            d['lastChange'] = self.last_change
-        if self.library:
-            d['library'] = self.library
-        if self.mappings:
-            d['mappings'] = [e.to_dict() for e in self.mappings]
-        if self.name:
+        if self.factors is not None:
+            d['factors'] = [e.to_dict() for e in self.factors]
+        if self.name is not None:
             d['name'] = self.name
-        if self.source:
-            d['source'] = self.source.to_dict()
-        if self.tags:
-            d['tags'] = self.tags
-        if self.target:
-            d['target'] = self.target.to_dict()
-        if self.version:
-            d['version'] = self.version
+        if self.weighted_score_unit is not None:
+            d['weightedScoreUnit'] = self.weighted_score_unit
         return d
 
-    def to_json(self) -> str:
-        return json.dumps(self.to_dict(), indent=2)
-
-    def to_ref(self) -> 'Ref':
-        ref = Ref(id=self.id, name=self.name)
-        ref.category = self.category
-        ref.model_type = 'FlowMap'
-        return ref
-
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'FlowMap':
-        flow_map = FlowMap()
-        if v := d.get('@type'):
-            flow_map.schema_type = v
-        if v := d.get('@id'):
-            flow_map.id = v
-        if v := d.get('category'):
-            flow_map.category = v
-        if v := d.get('description'):
-            flow_map.description = v
-        if v := d.get('lastChange'):
-            flow_map.last_change = v
-        if v := d.get('library'):
-            flow_map.library = v
-        if v := d.get('mappings'):
-            flow_map.mappings = [FlowMapEntry.from_dict(e) for e in v]
-        if v := d.get('name'):
-            flow_map.name = v
-        if v := d.get('source'):
-            flow_map.source = Ref.from_dict(v)
-        if v := d.get('tags'):
-            flow_map.tags = v
-        if v := d.get('target'):
-            flow_map.target = Ref.from_dict(v)
-        if v := d.get('version'):
-            flow_map.version = v
-        return flow_map
+    def from_dict(d: Dict[str, Any]) -> 'NwSet':
+        nw_set = NwSet()
+        if (v := d.get('@id')) is not None:
+            nw_set.id = v
+        if (v := d.get('description')) is not None:
+            nw_set.description = v
+        if (v := d.get('factors')) is not None:
+            nw_set.factors = [NwFactor.from_dict(e) for e in v]
+        if (v := d.get('name')) is not None:
+            nw_set.name = v
+        if (v := d.get('weightedScoreUnit')) is not None:
+            nw_set.weighted_score_unit = v
+        return nw_set
 
-    @staticmethod
-    def from_json(data: Union[str, bytes]) -> 'FlowMap':
-        return FlowMap.from_dict(json.loads(data))
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.id is None:
+            errors.append('id: value is required')
+        else:
+            _check_value(self.id, str, 'string', 'id', errors)
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.factors is not None:
+            if _check_value(self.factors, list, 'List[NwFactor]', 'factors', errors):
+                for i, e in enumerate(self.factors):
+                    _check_value(e, NwFactor, 'NwFactor', f'factors[{i}]', errors)
+        if self.name is None:
+            errors.append('name: value is required')
+        else:
+            _check_value(self.name, str, 'string', 'name', errors)
+        if self.weighted_score_unit is not None:
+            _check_value(self.weighted_score_unit, str, 'string', 'weighted_score_unit', errors)
+        return errors
 
 
 @dataclass
-class FlowProperty:
+class ParameterRedef:
+    """A redefinition of a parameter in a product system."""
 
-    id: Optional[str] = None
-    category: Optional[str] = None
+    context: Optional[Ref] = None
+    """The context of the paramater (a process or LCIA method). If no context
+    is provided it is assumed that this is a redefinition of a global
+    parameter.
+    """
     description: Optional[str] = None
-    flow_property_type: Optional[FlowPropertyType] = None
-    last_change: Optional[str] = None
-    library: Optional[str] = None
+    """A description of this parameter redefinition."""
+    is_protected: Optional[bool] = None
     name: Optional[str] = None
-    tags: Optional[List[str]] = None
-    unit_group: Optional[Ref] = None
-    version: Optional[str] = None
-
-    def __post_init__(self):
-        if self.id is None:
-            self.id = str(uuid.uuid4())
-        if self.version is None:
-            self.version = '01.00.000'
-        if self.last_change is None:
-            self.last_change = datetime.datetime.utcnow().isoformat() + 'Z'
+    """The name of the redefined parameter. Note that parameter names are used
+    in formulas so they need to follow specific syntax rules. A redefinition
+    replaces a bound parameter in a specific context and thus has to exactly
+    match the respective name.
+    """
+    uncertainty: Optional[Uncertainty] = None
+    """An uncertainty distribution for the redefined parameter value."""
+    value: Optional[float] = None
+    """The value of the redefined parameter."""
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        d['@type'] = 'FlowProperty'
-        if self.id:
-            d['@id'] = self.id
-        if self.category:
-            d['category'] = self.category
-        if self.description:
+        if self.context is not None:
+            d['context'] = self.context.to_dict()
+        if self.description is not None:
             d['description'] = self.description
-        if self.flow_property_type:
-            d['flowPropertyType'] = self.flow_property_type.value
-        if self.last_change:
-            d['lastChange'] = self.last_change
-        if self.library:
-            d['library'] = self.library
-        if self.name:
+        if self.is_protected is not None:
+            d['isProtected'] = self.is_protected
+        if self.name is not None:
             d['name'] = self.name
-        if self.tags:
-            d['tags'] = self.tags
-        if self.unit_group:
-            d['unitGroup'] = self.unit_group.to_dict()
-        if self.version:
-            d['version'] = self.version
+        if self.uncertainty is not None:
+            d['uncertainty'] = self.uncertainty.to_dict()
+        if self.value is not None:
+            d['value'] = self.value
         return d
 
-    def to_json(self) -> str:
-        return json.dumps(self.to_dict(), indent=2)
-
-    def to_ref(self) -> 'Ref':
-        ref = Ref(id=self.id, name=self.name)
-        ref.category = self.category
-        ref.model_type = 'FlowProperty'
-        return ref
-
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'FlowProperty':
-        flow_property = FlowProperty()
-        if v := d.get('@type'):
-            flow_property.schema_type = v
-        if v := d.get('@id'):
-            flow_property.id = v
-        if v := d.get('category'):
-            flow_property.category = v
-        if v := d.get('description'):
-            flow_property.description = v
-        if v := d.get('flowPropertyType'):
-            flow_property.flow_property_type = v
-        if v := d.get('lastChange'):
-            flow_property.last_change = v
-        if v := d.get('library'):
-            flow_property.library = v
-        if v := d.get('name'):
-            flow_property.name = v
-        if v := d.get('tags'):
-            flow_property.tags = v
-        if v := d.get('unitGroup'):
-            flow_property.unit_group = Ref[UnitGroup].from_dict(v)
-        if v := d.get('version'):
-            flow_property.version = v
-        return flow_property
+    def from_dict(d: Dict[str, Any]) -> 'ParameterRedef':
+        parameter_redef = ParameterRedef()
+        if (v := d.get('context')) is not None:
+            parameter_redef.context = Ref.from_dict(v)
+        if (v := d.get('description')) is not None:
+            parameter_redef.description = v
+        if (v := d.get('isProtected')) is not None:
+            parameter_redef.is_protected = v
+        if (v := d.get('name')) is not None:
+            parameter_redef.name = v
+        if (v := d.get('uncertainty')) is not None:
+            parameter_redef.uncertainty = Uncertainty.from_dict(v)
+        if (v := d.get('value')) is not None:
+            parameter_redef.value = v
+        return parameter_redef
 
-    @staticmethod
-    def from_json(data: Union[str, bytes]) -> 'FlowProperty':
-        return FlowProperty.from_dict(json.loads(data))
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.context is not None:
+            _check_value(self.context, Ref, 'Ref', 'context', errors)
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.is_protected is not None:
+            _check_value(self.is_protected, bool, 'boolean', 'is_protected', errors)
+        if self.name is not None:
+            _check_value(self.name, str, 'string', 'name', errors)
+        if self.uncertainty is not None:
+            _check_value(self.uncertainty, Uncertainty, 'Uncertainty', 'uncertainty', errors)
+        if self.value is not None:
+            _check_value(self.value, (int, float), 'double', 'value', errors)
+        return errors
 
 
 @dataclass
-class FlowPropertyFactor:
+class ParameterRedefSet:
+    """An instance of this class is just a set of parameter redefinitions
+    attached to a product system. It can have a name and a description. One
+    of the parameter sets can be defined as the baseline of the product
+    system. In the calculation the baseline set is then taken by default.
+    """
 
-    conversion_factor: Optional[float] = None
-    flow_property: Optional[Ref] = None
-    is_ref_flow_property: Optional[bool] = None
+    description: Optional[str] = None
+    """A description of the parameter set."""
+    is_baseline: Optional[bool] = None
+    """Indicates if this set of parameter redefinitions is the baseline for a
+    product system.
+    """
+    name: Optional[str] = None
+    """The name of the parameter set."""
+    parameters: Optional[List[ParameterRedef]] = None
+    """The parameter redefinitions of this redefinition set."""
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        if self.conversion_factor:
-            d['conversionFactor'] = self.conversion_factor
-        if self.flow_property:
-            d['flowProperty'] = self.flow_property.to_dict()
-        if self.is_ref_flow_property:
-            d['isRefFlowProperty'] = self.is_ref_flow_property
+        if self.description is not None:
+            d['description'] = self.description
+        if self.is_baseline is not None:
+            d['isBaseline'] = self.is_baseline
+        if self.name is not None:
+            d['name'] = self.name
+        if self.parameters is not None:
+            d['parameters'] = [e.to_dict() for e in self.parameters]
         return d
 
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'FlowPropertyFactor':
-        flow_property_factor = FlowPropertyFactor()
-        if v := d.get('@type'):
-            flow_property_factor.schema_type = v
-        if v := d.get('conversionFactor'):
-            flow_property_factor.conversion_factor = v
-        if v := d.get('flowProperty'):
-            flow_property_factor.flow_property = Ref[FlowProperty].from_dict(v)
-        if v := d.get('isRefFlowProperty'):
-            flow_property_factor.is_ref_flow_property = v
-        return flow_property_factor
+    def from_dict(d: Dict[str, Any]) -> 'ParameterRedefSet':
+        parameter_redef_set = ParameterRedefSet()
+        if (v := d.get('description')) is not None:
+            parameter_redef_set.description = v
+        if (v := d.get('isBaseline')) is not None:
+            parameter_redef_set.is_baseline = v
+        if (v := d.get('name')) is not None:
+            parameter_redef_set.name = v
+        if (v := d.get('parameters')) is not None:
+            parameter_redef_set.parameters = [ParameterRedef.from_dict(e) for e in v]
+        return parameter_redef_set
+
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.is_baseline is not None:
+            _check_value(self.is_baseline, bool, 'boolean', 'is_baseline', errors)
+        if self.name is not None:
+            _check_value(self.name, str, 'string', 'name', errors)
+        if self.parameters is not None:
+            if _check_value(self.parameters, list, 'List[ParameterRedef]', 'parameters', errors):
+                for i, e in enumerate(self.parameters):
+                    _check_value(e, ParameterRedef, 'ParameterRedef', f'parameters[{i}]', errors)
+        return errors
+
+
+@dataclass
+class ProcessDocumentation:
+
+    completeness_description: Optional[str] = None
+    creation_date: Optional[str] = None
+    data_collection_description: Optional[str] = None
+    data_documentor: Optional[Ref[Actor]] = None
+    data_generator: Optional[Ref[Actor]] = None
+    data_selection_description: Optional[str] = None
+    data_set_owner: Optional[Ref[Actor]] = None
+    data_treatment_description: Optional[str] = None
+    geography_description: Optional[str] = None
+    intended_application: Optional[str] = None
+    inventory_method_description: Optional[str] = None
+    is_copyright_protected: Optional[bool] = None
+    modeling_constants_description: Optional[str] = None
+    project_description: Optional[str] = None
+    publication: Optional[Ref[Source]] = None
+    restrictions_description: Optional[str] = None
+    review_details: Optional[str] = None
+    reviewer: Optional[Ref[Actor]] = None
+    sampling_description: Optional[str] = None
+    sources: Optional[List[Ref[Source]]] = None
+    technology_description: Optional[str] = None
+    time_description: Optional[str] = None
+    valid_from: Optional[str] = None
+    valid_until: Optional[str] = None
+
+    def to_dict(self) -> Dict[str, Any]:
+        d: Dict[str, Any] = {}
+        if self.completeness_description is not None:
+            d['completenessDescription'] = self.completeness_description
+        if self.creation_date is not None:
+            d['creationDate'] = self.creation_date
+        if self.data_collection_description is not None:
+            d['dataCollectionDescription'] = self.data_collection_description
+        if self.data_documentor is not None:
+            d['dataDocumentor'] = self.data_documentor.to_dict()
+        if self.data_generator is not None:
+            d['dataGenerator'] = self.data_generator.to_dict()
+        if self.data_selection_description is not None:
+            d['dataSelectionDescription'] = self.data_selection_description
+        if self.data_set_owner is not None:
+            d['dataSetOwner'] = self.data_set_owner.to_dict()
+        if self.data_treatment_description is not None:
+            d['dataTreatmentDescription'] = self.data_treatment_description
+        if self.geography_description is not None:
+            d['geographyDescription'] = self.geography_description
+        if self.intended_application is not None:
+            d['intendedApplication'] = self.intended_application
+        if self.inventory_method_description is not None:
+            d['inventoryMethodDescription'] = self.inventory_method_description
+        if self.is_copyright_protected is not None:
+            d['isCopyrightProtected'] = self.is_copyright_protected
+        if self.modeling_constants_description is not None:
+            d['modelingConstantsDescription'] = self.modeling_constants_description
+        if self.project_description is not None:
+            d['projectDescription'] = self.project_description
+        if self.publication is not None:
+            d['publication'] = self.publication.to_dict()
+        if self.restrictions_description is not None:
+            d['restrictionsDescription'] = self.restrictions_description
+        if self.review_details is not None:
+            d['reviewDetails'] = self.review_details
+        if self.reviewer is not None:
+            d['reviewer'] = self.reviewer.to_dict()
+        if self.sampling_description is not None:
+            d['samplingDescription'] = self.sampling_description
+        if self.sources is not None:
+            d['sources'] = [e.to_dict() for e in self.sources]
+        if self.technology_description is not None:
+            d['technologyDescription'] = self.technology_description
+        if self.time_description is not None:
+            d['timeDescription'] = self.time_description
+        if self.valid_from is not None:
+            d['validFrom'] = self.valid_from
+        if self.valid_until is not None:
+            d['validUntil'] = self.valid_until
+        return d
+
+    @staticmethod
+    def from_dict(d: Dict[str, Any]) -> 'ProcessDocumentation':
+        process_documentation = ProcessDocumentation()
+        if (v := d.get('completenessDescription')) is not None:
+            process_documentation.completeness_description = v
+        if (v := d.get('creationDate')) is not None:
+            process_documentation.creation_date = v
+        if (v := d.get('dataCollectionDescription')) is not None:
+            process_documentation.data_collection_description = v
+        if (v := d.get('dataDocumentor')) is not None:
+            process_documentation.data_documentor = Ref.from_dict(v, 'Actor')
+        if (v := d.get('dataGenerator')) is not None:
+            process_documentation.data_generator = Ref.from_dict(v, 'Actor')
+        if (v := d.get('dataSelectionDescription')) is not None:
+            process_documentation.data_selection_description = v
+        if (v := d.get('dataSetOwner')) is not None:
+            process_documentation.data_set_owner = Ref.from_dict(v, 'Actor')
+        if (v := d.get('dataTreatmentDescription')) is not None:
+            process_documentation.data_treatment_description = v
+        if (v := d.get('geographyDescription')) is not None:
+            process_documentation.geography_description = v
+        if (v := d.get('intendedApplication')) is not None:
+            process_documentation.intended_application = v
+        if (v := d.get('inventoryMethodDescription')) is not None:
+            process_documentation.inventory_method_description = v
+        if (v := d.get('isCopyrightProtected')) is not None:
+            process_documentation.is_copyright_protected = v
+        if (v := d.get('modelingConstantsDescription')) is not None:
+            process_documentation.modeling_constants_description = v
+        if (v := d.get('projectDescription')) is not None:
+            process_documentation.project_description = v
+        if (v := d.get('publication')) is not None:
+            process_documentation.publication = Ref.from_dict(v, 'Source')
+        if (v := d.get('restrictionsDescription')) is not None:
+            process_documentation.restrictions_description = v
+        if (v := d.get('reviewDetails')) is not None:
+            process_documentation.review_details = v
+        if (v := d.get('reviewer')) is not None:
+            process_documentation.reviewer = Ref.from_dict(v, 'Actor')
+        if (v := d.get('samplingDescription')) is not None:
+            process_documentation.sampling_description = v
+        if (v := d.get('sources')) is not None:
+            process_documentation.sources = [Ref.from_dict(e, 'Source') for e in v]
+        if (v := d.get('technologyDescription')) is not None:
+            process_documentation.technology_description = v
+        if (v := d.get('timeDescription')) is not None:
+            process_documentation.time_description = v
+        if (v := d.get('validFrom')) is not None:
+            process_documentation.valid_from = v
+        if (v := d.get('validUntil')) is not None:
+            process_documentation.valid_until = v
+        return process_documentation
+
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.completeness_description is not None:
+            _check_value(self.completeness_description, str, 'string', 'completeness_description', errors)
+        if self.creation_date is not None:
+            _check_value(self.creation_date, str, 'dateTime', 'creation_date', errors)
+        if self.data_collection_description is not None:
+            _check_value(self.data_collection_description, str, 'string', 'data_collection_description', errors)
+        if self.data_documentor is not None:
+            _check_value(self.data_documentor, Ref, 'Ref[Actor]', 'data_documentor', errors)
+        if self.data_generator is not None:
+            _check_value(self.data_generator, Ref, 'Ref[Actor]', 'data_generator', errors)
+        if self.data_selection_description is not None:
+            _check_value(self.data_selection_description, str, 'string', 'data_selection_description', errors)
+        if self.data_set_owner is not None:
+            _check_value(self.data_set_owner, Ref, 'Ref[Actor]', 'data_set_owner', errors)
+        if self.data_treatment_description is not None:
+            _check_value(self.data_treatment_description, str, 'string', 'data_treatment_description', errors)
+        if self.geography_description is not None:
+            _check_value(self.geography_description, str, 'string', 'geography_description', errors)
+        if self.intended_application is not None:
+            _check_value(self.intended_application, str, 'string', 'intended_application', errors)
+        if self.inventory_method_description is not None:
+            _check_value(self.inventory_method_description, str, 'string', 'inventory_method_description', errors)
+        if self.is_copyright_protected is not None:
+            _check_value(self.is_copyright_protected, bool, 'boolean', 'is_copyright_protected', errors)
+        if self.modeling_constants_description is not None:
+            _check_value(self.modeling_constants_description, str, 'string', 'modeling_constants_description', errors)
+        if self.project_description is not None:
+            _check_value(self.project_description, str, 'string', 'project_description', errors)
+        if self.publication is not None:
+            _check_value(self.publication, Ref, 'Ref[Source]', 'publication', errors)
+        if self.restrictions_description is not None:
+            _check_value(self.restrictions_description, str, 'string', 'restrictions_description', errors)
+        if self.review_details is not None:
+            _check_value(self.review_details, str, 'string', 'review_details', errors)
+        if self.reviewer is not None:
+            _check_value(self.reviewer, Ref, 'Ref[Actor]', 'reviewer', errors)
+        if self.sampling_description is not None:
+            _check_value(self.sampling_description, str, 'string', 'sampling_description', errors)
+        if self.sources is not None:
+            if _check_value(self.sources, list, 'List[Ref[Source]]', 'sources', errors):
+                for i, e in enumerate(self.sources):
+                    _check_value(e, Ref, 'Ref[Source]', f'sources[{i}]', errors)
+        if self.technology_description is not None:
+            _check_value(self.technology_description, str, 'string', 'technology_description', errors)
+        if self.time_description is not None:
+            _check_value(self.time_description, str, 'string', 'time_description', errors)
+        if self.valid_from is not None:
+            _check_value(self.valid_from, str, 'date', 'valid_from', errors)
+        if self.valid_until is not None:
+            _check_value(self.valid_until, str, 'date', 'valid_until', errors)
+        return errors
+
+
+@dataclass
+class ProcessLink:
+    """A process link is a connection between two processes in a product
+    system.
+    """
+
+    exchange: Optional[ExchangeRef] = None
+    """The exchange of the linked process (this is useful if the linked process
+    has multiple exchanges with the same flow that are linked to different
+    provides, e.g. in an electricity mix).
+    """
+    flow: Optional[Ref[Flow]] = None
+    """The descriptor of the flow that is exchanged between the two processes.
+    """
+    process: Optional[Ref[Process]] = None
+    """The descriptor of the process that is linked to the provider."""
+    provider: Optional[Ref] = None
+    """The descriptor of the process or product system that provides a product
+    or a waste treatment.
+    """
+
+    def to_dict(self) -> Dict[str, Any]:
+        d: Dict[str, Any] = {}
+        if self.exchange is not None:
+            d['exchange'] = self.exchange.to_dict()
+        if self.flow is not None:
+            d['flow'] = self.flow.to_dict()
+        if self.process is not None:
+            d['process'] = self.process.to_dict()
+        if self.provider is not None:
+            d['provider'] = self.provider.to_dict()
+        return d
+
+    @staticmethod
+    def from_dict(d: Dict[str, Any]) -> 'ProcessLink':
+        process_link = ProcessLink()
+        if (v := d.get('exchange')) is not None:
+            process_link.exchange = ExchangeRef.from_dict(v)
+        if (v := d.get('flow')) is not None:
+            process_link.flow = Ref.from_dict(v, 'Flow')
+        if (v := d.get('process')) is not None:
+            process_link.process = Ref.from_dict(v, 'Process')
+        if (v := d.get('provider')) is not None:
+            process_link.provider = Ref.from_dict(v)
+        return process_link
+
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.exchange is not None:
+            _check_value(self.exchange, ExchangeRef, 'ExchangeRef', 'exchange', errors)
+        if self.flow is not None:
+            _check_value(self.flow, Ref, 'Ref[Flow]', 'flow', errors)
+        if self.process is not None:
+            _check_value(self.process, Ref, 'Ref[Process]', 'process', errors)
+        if self.provider is not None:
+            _check_value(self.provider, Ref, 'Ref', 'provider', errors)
+        return errors
+
+
+@dataclass
+class ProjectVariant:
+
+    allocation_method: Optional[AllocationType] = None
+    amount: Optional[float] = None
+    description: Optional[str] = None
+    is_disabled: Optional[bool] = None
+    name: Optional[str] = None
+    parameter_redefs: Optional[List[ParameterRedef]] = None
+    product_system: Optional[Ref[ProductSystem]] = None
+    unit: Optional[Ref[Unit]] = None
+
+    def to_dict(self) -> Dict[str, Any]:
+        d: Dict[str, Any] = {}
+        if self.allocation_method is not None:
+            d['allocationMethod'] = self.allocation_method.value
+        if self.amount is not None:
+            d['amount'] = self.amount
+        if self.description is not None:
+            d['description'] = self.description
+        if self.is_disabled is not None:
+            d['isDisabled'] = self.is_disabled
+        if self.name is not None:
+            d['name'] = self.name
+        if self.parameter_redefs is not None:
+            d['parameterRedefs'] = [e.to_dict() for e in self.parameter_redefs]
+        if self.product_system is not None:
+            d['productSystem'] = self.product_system.to_dict()
+        if self.unit is not None:
+            d['unit'] = self.unit.to_dict()
+        return d
+
+    @staticmethod
+    def from_dict(d: Dict[str, Any]) -> 'ProjectVariant':
+        project_variant = ProjectVariant()
+        if (v := d.get('allocationMethod')) is not None:
+            project_variant.allocation_method = AllocationType(v)
+        if (v := d.get('amount')) is not None:
+            project_variant.amount = v
+        if (v := d.get('description')) is not None:
+            project_variant.description = v
+        if (v := d.get('isDisabled')) is not None:
+            project_variant.is_disabled = v
+        if (v := d.get('name')) is not None:
+            project_variant.name = v
+        if (v := d.get('parameterRedefs')) is not None:
+            project_variant.parameter_redefs = [ParameterRedef.from_dict(e) for e in v]
+        if (v := d.get('productSystem')) is not None:
+            project_variant.product_system = Ref.from_dict(v, 'ProductSystem')
+        if (v := d.get('unit')) is not None:
+            project_variant.unit = Ref.from_dict(v, 'Unit')
+        return project_variant
+
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.allocation_method is not None:
+            _check_value(self.allocation_method, AllocationType, 'AllocationType', 'allocation_method', errors)
+        if self.amount is not None:
+            _check_value(self.amount, (int, float), 'double', 'amount', errors)
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.is_disabled is not None:
+            _check_value(self.is_disabled, bool, 'boolean', 'is_disabled', errors)
+        if self.name is not None:
+            _check_value(self.name, str, 'string', 'name', errors)
+        if self.parameter_redefs is not None:
+            if _check_value(self.parameter_redefs, list, 'List[ParameterRedef]', 'parameter_redefs', errors):
+                for i, e in enumerate(self.parameter_redefs):
+                    _check_value(e, ParameterRedef, 'ParameterRedef', f'parameter_redefs[{i}]', errors)
+        if self.product_system is not None:
+            _check_value(self.product_system, Ref, 'Ref[ProductSystem]', 'product_system', errors)
+        if self.unit is not None:
+            _check_value(self.unit, Ref, 'Ref[Unit]', 'unit', errors)
+        return errors
+
+
+@dataclass
+class Ref(Generic[_T]):
+    """A Ref is a reference to some entity. When serializing an entity (e.g. a
+    Process) that references another standalone entity (e.g. a Flow in an
+    Exchange) we do not want to write the complete referenced entity into
+    the serialized JSON object but just a reference. However, the reference
+    contains some meta-data like name, category path etc. that are useful to
+    display.
+    """
+
+    id: Optional[str] = None
+    """The reference ID (or UUID) of this entity."""
+    category: Optional[str] = None
+    """The category path of the referenced entity, e.g. Elementary
+    flows/Emissions to air/unspecified.
+    """
+    description: Optional[str] = None
+    """The description of the entity."""
+    flow_type: Optional[FlowType] = None
+    """In case of a reference to a flow, this field can contain the type of
+    flow that is referenced.
+    """
+    library: Optional[str] = None
+    """If the entity that is described by this reference is part of a library,
+    this field contains the identifier of that library. The identifier is
+    typically just the combination of the library name and version.
+    """
+    location: Optional[str] = None
+    """This field is only valid for references of processes or flows and
+    contains the location name or code of that respective process or flow.
+    """
+    name: Optional[str] = None
+    """The name of the entity."""
+    process_type: Optional[ProcessType] = None
+    """In case of a reference to a process, this fiel can contain the type of
+    process that is referenced.
+    """
+    ref_unit: Optional[str] = None
+    """This field is valid for references to entities which can have a
+    (reference) unit, like flows, impact categories, or flow properties.
+    """
+    model_type: str = ''
+
+    def to_dict(self) -> Dict[str, Any]:
+        d: Dict[str, Any] = {}
+        d['@type'] = self.model_type
+        if self.id is not None:
+            d['@id'] = self.id
+        if self.category is not None:
+            d['category'] = self.category
+        if self.description is not None:
+            d['description'] = self.description
+        if self.flow_type is not None:
+            d['flowType'] = self.flow_type.value
+        if self.library is not None:
+            d['library'] = self.library
+        if self.location is not None:
+            d['location'] = self.location
+        if self.name is not None:
+            d['name'] = self.name
+        if self.process_type is not None:
+            d['processType'] = self.process_type.value
+        if self.ref_unit is not None:
+            d['refUnit'] = self.ref_unit
+        return d
+
+    @staticmethod
+    def from_dict(d: Dict[str, Any], *model_types: str) -> 'Ref[Any]':
+        ref: Ref[Any] = Ref()
+        ref.model_type = d.get('@type', '')
+        if model_types and ref.model_type and ref.model_type not in model_types:
+            raise ValueError(f'invalid @type of reference: {ref.model_type}, expected: {", ".join(model_types)}')
+        if (v := d.get('@id')) is not None:
+            ref.id = v
+        if (v := d.get('category')) is not None:
+            ref.category = v
+        if (v := d.get('description')) is not None:
+            ref.description = v
+        if (v := d.get('flowType')) is not None:
+            ref.flow_type = FlowType(v)
+        if (v := d.get('library')) is not None:
+            ref.library = v
+        if (v := d.get('location')) is not None:
+            ref.location = v
+        if (v := d.get('name')) is not None:
+            ref.name = v
+        if (v := d.get('processType')) is not None:
+            ref.process_type = ProcessType(v)
+        if (v := d.get('refUnit')) is not None:
+            ref.ref_unit = v
+        return ref
+
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.id is None:
+            errors.append('id: value is required')
+        else:
+            _check_value(self.id, str, 'string', 'id', errors)
+        if self.category is not None:
+            _check_value(self.category, str, 'string', 'category', errors)
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.flow_type is not None:
+            _check_value(self.flow_type, FlowType, 'FlowType', 'flow_type', errors)
+        if self.library is not None:
+            _check_value(self.library, str, 'string', 'library', errors)
+        if self.location is not None:
+            _check_value(self.location, str, 'string', 'location', errors)
+        if self.name is None:
+            errors.append('name: value is required')
+        else:
+            _check_value(self.name, str, 'string', 'name', errors)
+        if self.process_type is not None:
+            _check_value(self.process_type, ProcessType, 'ProcessType', 'process_type', errors)
+        if self.ref_unit is not None:
+            _check_value(self.ref_unit, str, 'string', 'ref_unit', errors)
+        return errors
+
+
+@dataclass
+class SocialAspect:
+    """An instance of this class describes a social aspect related to a social
+    indicator in a process.
+    """
+
+    activity_value: Optional[float] = None
+    """The value of the activity variable of the related indicator."""
+    comment: Optional[str] = None
+    quality: Optional[str] = None
+    """A data quality entry, e.g. (3,1,2,4,1)."""
+    raw_amount: Optional[str] = None
+    """The raw amount of the indicator's unit of measurement (not required to
+    be numeric currently)
+    """
+    risk_level: Optional[RiskLevel] = None
+    social_indicator: Optional[Ref[SocialIndicator]] = None
+    source: Optional[Ref[Source]] = None
+
+    def to_dict(self) -> Dict[str, Any]:
+        d: Dict[str, Any] = {}
+        if self.activity_value is not None:
+            d['activityValue'] = self.activity_value
+        if self.comment is not None:
+            d['comment'] = self.comment
+        if self.quality is not None:
+            d['quality'] = self.quality
+        if self.raw_amount is not None:
+            d['rawAmount'] = self.raw_amount
+        if self.risk_level is not None:
+            d['riskLevel'] = self.risk_level.value
+        if self.social_indicator is not None:
+            d['socialIndicator'] = self.social_indicator.to_dict()
+        if self.source is not None:
+            d['source'] = self.source.to_dict()
+        return d
+
+    @staticmethod
+    def from_dict(d: Dict[str, Any]) -> 'SocialAspect':
+        social_aspect = SocialAspect()
+        if (v := d.get('activityValue')) is not None:
+            social_aspect.activity_value = v
+        if (v := d.get('comment')) is not None:
+            social_aspect.comment = v
+        if (v := d.get('quality')) is not None:
+            social_aspect.quality = v
+        if (v := d.get('rawAmount')) is not None:
+            social_aspect.raw_amount = v
+        if (v := d.get('riskLevel')) is not None:
+            social_aspect.risk_level = RiskLevel(v)
+        if (v := d.get('socialIndicator')) is not None:
+            social_aspect.social_indicator = Ref.from_dict(v, 'SocialIndicator')
+        if (v := d.get('source')) is not None:
+            social_aspect.source = Ref.from_dict(v, 'Source')
+        return social_aspect
+
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.activity_value is not None:
+            _check_value(self.activity_value, (int, float), 'double', 'activity_value', errors)
+        if self.comment is not None:
+            _check_value(self.comment, str, 'string', 'comment', errors)
+        if self.quality is not None:
+            _check_value(self.quality, str, 'string', 'quality', errors)
+        if self.raw_amount is not None:
+            _check_value(self.raw_amount, str, 'string', 'raw_amount', errors)
+        if self.risk_level is not None:
+            _check_value(self.risk_level, RiskLevel, 'RiskLevel', 'risk_level', errors)
+        if self.social_indicator is not None:
+            _check_value(self.social_indicator, Ref, 'Ref[SocialIndicator]', 'social_indicator', errors)
+        if self.source is not None:
+            _check_value(self.source, Ref, 'Ref[Source]', 'source', errors)
+        return errors
+
+
+@dataclass
+class Uncertainty:
+    """Defines the parameter values of an uncertainty distribution. Depending
+    on the uncertainty distribution type different parameters could be used.
+    """
+
+    distribution_type: Optional[UncertaintyType] = None
+    """The uncertainty distribution type"""
+    geom_mean: Optional[float] = None
+    """The geometric mean value (used for log-normal distributions)."""
+    geom_mean_formula: Optional[str] = None
+    """A mathematical formula for the geometric mean."""
+    geom_sd: Optional[float] = None
+    """The geometric standard deviation (used for log-normal distributions)."""
+    geom_sd_formula: Optional[str] = None
+    """A mathematical formula for the geometric standard deviation."""
+    maximum: Optional[float] = None
+    """The maximum value (used for uniform and triangle distributions)."""
+    maximum_formula: Optional[str] = None
+    """A mathematical formula for the maximum value."""
+    mean: Optional[float] = None
+    """The arithmetic mean (used for normal distributions)."""
+    mean_formula: Optional[str] = None
+    """A mathematical formula for the arithmetic mean."""
+    minimum: Optional[float] = None
+    """The minimum value (used for uniform and triangle distributions)."""
+    minimum_formula: Optional[str] = None
+    """A mathematical formula for the minimum value."""
+    mode: Optional[float] = None
+    """The most likely value (used for triangle distributions)."""
+    mode_formula: Optional[str] = None
+    """A mathematical formula for the most likely value."""
+    sd: Optional[float] = None
+    """The arithmetic standard deviation (used for normal distributions)."""
+    sd_formula: Optional[str] = None
+    """A mathematical formula for the arithmetic standard deviation."""
+
+    def to_dict(self) -> Dict[str, Any]:
+        d: Dict[str, Any] = {}
+        if self.distribution_type is not None:
+            d['distributionType'] = self.distribution_type.value
+        if self.geom_mean is not None:
+            d['geomMean'] = self.geom_mean
+        if self.geom_mean_formula is not None:
+            d['geomMeanFormula'] = self.geom_mean_formula
+        if self.geom_sd is not None:
+            d['geomSd'] = self.geom_sd
+        if self.geom_sd_formula is not None:
+            d['geomSdFormula'] = self.geom_sd_formula
+        if self.maximum is not None:
+            d['maximum'] = self.maximum
+        if self.maximum_formula is not None:
+            d['maximumFormula'] = self.maximum_formula
+        if self.mean is not None:
+            d['mean'] = self.mean
+        if self.mean_formula is not None:
+            d['meanFormula'] = self.mean_formula
+        if self.minimum is not None:
+            d['minimum'] = self.minimum
+        if self.minimum_formula is not None:
+            d['minimumFormula'] = self.minimum_formula
+        if self.mode is not None:
+            d['mode'] = self.mode
+        if self.mode_formula is not None:
+            d['modeFormula'] = self.mode_formula
+        if self.sd is not None:
+            d['sd'] = self.sd
+        if self.sd_formula is not None:
+            d['sdFormula'] = self.sd_formula
+        return d
+
+    @staticmethod
+    def from_dict(d: Dict[str, Any]) -> 'Uncertainty':
+        uncertainty = Uncertainty()
+        if (v := d.get('distributionType')) is not None:
+            uncertainty.distribution_type = UncertaintyType(v)
+        if (v := d.get('geomMean')) is not None:
+            uncertainty.geom_mean = v
+        if (v := d.get('geomMeanFormula')) is not None:
+            uncertainty.geom_mean_formula = v
+        if (v := d.get('geomSd')) is not None:
+            uncertainty.geom_sd = v
+        if (v := d.get('geomSdFormula')) is not None:
+            uncertainty.geom_sd_formula = v
+        if (v := d.get('maximum')) is not None:
+            uncertainty.maximum = v
+        if (v := d.get('maximumFormula')) is not None:
+            uncertainty.maximum_formula = v
+        if (v := d.get('mean')) is not None:
+            uncertainty.mean = v
+        if (v := d.get('meanFormula')) is not None:
+            uncertainty.mean_formula = v
+        if (v := d.get('minimum')) is not None:
+            uncertainty.minimum = v
+        if (v := d.get('minimumFormula')) is not None:
+            uncertainty.minimum_formula = v
+        if (v := d.get('mode')) is not None:
+            uncertainty.mode = v
+        if (v := d.get('modeFormula')) is not None:
+            uncertainty.mode_formula = v
+        if (v := d.get('sd')) is not None:
+            uncertainty.sd = v
+        if (v := d.get('sdFormula')) is not None:
+            uncertainty.sd_formula = v
+        return uncertainty
+
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.distribution_type is not None:
+            _check_value(self.distribution_type, UncertaintyType, 'UncertaintyType', 'distribution_type', errors)
+        if self.geom_mean is not None:
+            _check_value(self.geom_mean, (int, float), 'double', 'geom_mean', errors)
+        if self.geom_mean_formula is not None:
+            _check_value(self.geom_mean_formula, str, 'string', 'geom_mean_formula', errors)
+        if self.geom_sd is not None:
+            _check_value(self.geom_sd, (int, float), 'double', 'geom_sd', errors)
+        if self.geom_sd_formula is not None:
+            _check_value(self.geom_sd_formula, str, 'string', 'geom_sd_formula', errors)
+        if self.maximum is not None:
+            _check_value(self.maximum, (int, float), 'double', 'maximum', errors)
+        if self.maximum_formula is not None:
+            _check_value(self.maximum_formula, str, 'string', 'maximum_formula', errors)
+        if self.mean is not None:
+            _check_value(self.mean, (int, float), 'double', 'mean', errors)
+        if self.mean_formula is not None:
+            _check_value(self.mean_formula, str, 'string', 'mean_formula', errors)
+        if self.minimum is not None:
+            _check_value(self.minimum, (int, float), 'double', 'minimum', errors)
+        if self.minimum_formula is not None:
+            _check_value(self.minimum_formula, str, 'string', 'minimum_formula', errors)
+        if self.mode is not None:
+            _check_value(self.mode, (int, float), 'double', 'mode', errors)
+        if self.mode_formula is not None:
+            _check_value(self.mode_formula, str, 'string', 'mode_formula', errors)
+        if self.sd is not None:
+            _check_value(self.sd, (int, float), 'double', 'sd', errors)
+        if self.sd_formula is not None:
+            _check_value(self.sd_formula, str, 'string', 'sd_formula', errors)
+        return errors
+
+
+@dataclass
+class Unit:
+    """An unit of measure"""
+
+    id: Optional[str] = None
+    """The reference ID (or UUID) of this entity."""
+    conversion_factor: Optional[float] = None
+    """The conversion factor to the reference unit of the unit group to which
+    this unit belongs.
+    """
+    description: Optional[str] = None
+    """The description of the entity."""
+    is_ref_unit: Optional[bool] = None
+    """Indicates whether the unit is the reference unit of the unit group to
+    which this unit belongs. If it is the reference unit the conversion
+    factor must be 1.0. There should be always only one reference unit in a
+    unit group. The reference unit is used to convert amounts given in one
+    unit to amounts given in another unit of the respective unit group.
+    """
+    name: Optional[str] = None
+    """The name of the entity."""
+    synonyms: Optional[List[str]] = None
+    """A list of synonyms for the unit."""
+
+    def to_dict(self) -> Dict[str, Any]:
+        d: Dict[str, Any] = {}
+        if self.id is not None:
+            d['@id'] = self.id
+        if self.conversion_factor is not None:
+            d['conversionFactor'] = self.conversion_factor
+        if self.description is not None:
+            d['description'] = self.description
+        if self.is_ref_unit is not None:
+            d['isRefUnit'] = self.is_ref_unit
+        if self.name is not None:
+            d['name'] = self.name
+        if self.synonyms is not None:
+            d['synonyms'] = self.synonyms
+        return d
+
+    def to_ref(self) -> 'Ref[Unit]':
+        ref: Ref[Unit] = Ref(id=self.id, name=self.name)
+        ref.model_type = 'Unit'
+        return ref
+
+    @staticmethod
+    def from_dict(d: Dict[str, Any]) -> 'Unit':
+        unit = Unit()
+        if (v := d.get('@id')) is not None:
+            unit.id = v
+        if (v := d.get('conversionFactor')) is not None:
+            unit.conversion_factor = v
+        if (v := d.get('description')) is not None:
+            unit.description = v
+        if (v := d.get('isRefUnit')) is not None:
+            unit.is_ref_unit = v
+        if (v := d.get('name')) is not None:
+            unit.name = v
+        if (v := d.get('synonyms')) is not None:
+            unit.synonyms = v
+        return unit
+
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.id is None:
+            errors.append('id: value is required')
+        else:
+            _check_value(self.id, str, 'string', 'id', errors)
+        if self.conversion_factor is not None:
+            _check_value(self.conversion_factor, (int, float), 'double', 'conversion_factor', errors)
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.is_ref_unit is not None:
+            _check_value(self.is_ref_unit, bool, 'boolean', 'is_ref_unit', errors)
+        if self.name is None:
+            errors.append('name: value is required')
+        else:
+            _check_value(self.name, str, 'string', 'name', errors)
+        if self.synonyms is not None:
+            if _check_value(self.synonyms, list, 'List[string]', 'synonyms', errors):
+                for i, e in enumerate(self.synonyms):
+                    _check_value(e, str, 'string', f'synonyms[{i}]', errors)
+        return errors
 
 
 @dataclass
-class Flow:
+class Actor:
+    """An actor is a person or organisation."""
 
-    id: Optional[str] = None
-    cas: Optional[str] = None
+    id: Optional[str] = field(default_factory=lambda: str(uuid.uuid4()))
+    """The reference ID (or UUID) of this entity."""
+    address: Optional[str] = None
     category: Optional[str] = None
+    """A full path of the category. Forward slashes are used to separate the
+    segments of this path, e.g. Elementary flows/emissions/air/unspecified.
+    """
+    city: Optional[str] = None
+    country: Optional[str] = None
     description: Optional[str] = None
-    flow_properties: Optional[List[FlowPropertyFactor]] = None
-    flow_type: Optional[FlowType] = None
-    formula: Optional[str] = None
-    is_infrastructure_flow: Optional[bool] = None
+    """The description of the entity."""
+    email: Optional[str] = None
     last_change: Optional[str] = None
+    """The timestamp when the entity was changed the last time."""
     library: Optional[str] = None
-    location: Optional[Ref] = None
+    """If this entity is part of a library, this field contains the identifier
+    of that library. The identifier is typically just the combination of the
+    library name and version.
+    """
     name: Optional[str] = None
-    synonyms: Optional[str] = None
+    """The name of the entity."""
     tags: Optional[List[str]] = None
-    version: Optional[str] = None
+    """A list of optional tags. A tag is just a string which should not contain
+    commas (and other special characters).
+    """
+    telefax: Optional[str] = None
+    telephone: Optional[str] = None
+    version: Optional[str] = '01.00.000'
+    """A version number in MAJOR.MINOR.PATCH format where the MINOR and PATCH
+    fields are optional and the fields may have leading zeros (so 01.00.00
+    is the same as 1.0.0 or 1).
+    """
+    website: Optional[str] = None
+    zip_code: Optional[str] = None
 
-    def __post_init__(self):
-        if self.id is None:
-            self.id = str(uuid.uuid4())
+    def __post_init__(self) -> None:
         if self.version is None:
             self.version = '01.00.000'
         if self.last_change is None:
@@ -1165,196 +2176,172 @@ class Flow:
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        d['@type'] = 'Flow'
-        if self.id:
+        d['@type'] = 'Actor'
+        if self.id is not None:
             d['@id'] = self.id
-        if self.cas:
-            d['cas'] = self.cas
-        if self.category:
+        if self.address is not None:
+            d['address'] = self.address
+        if self.category is not None:
             d['category'] = self.category
-        if self.description:
+        if self.city is not None:
+            d['city'] = self.city
+        if self.country is not None:
+            d['country'] = self.country
+        if self.description is not None:
             d['description'] = self.description
-        if self.flow_properties:
-            d['flowProperties'] = [e.to_dict() for e in self.flow_properties]
-        if self.flow_type:
-            d['flowType'] = self.flow_type.value
-        if self.formula:
-            d['formula'] = self.formula
-        if self.is_infrastructure_flow:
-            d['isInfrastructureFlow'] = self.is_infrastructure_flow
-        if self.last_change:
+        if self.email is not None:
+            d['email'] = self.email
+        if self.last_change is not None:
             d['lastChange'] = self.last_change
-        if self.library:
+        if self.library is not None:
             d['library'] = self.library
-        if self.location:
-            d['location'] = self.location.to_dict()
-        if self.name:
+        if self.name is not None:
             d['name'] = self.name
-        if self.synonyms:
-            d['synonyms'] = self.synonyms
-        if self.tags:
+        if self.tags is not None:
             d['tags'] = self.tags
-        if self.version:
+        if self.telefax is not None:
+            d['telefax'] = self.telefax
+        if self.telephone is not None:
+            d['telephone'] = self.telephone
+        if self.version is not None:
             d['version'] = self.version
+        if self.website is not None:
+            d['website'] = self.website
+        if self.zip_code is not None:
+            d['zipCode'] = self.zip_code
         return d
 
     def to_json(self) -> str:
         return json.dumps(self.to_dict(), indent=2)
 
-    def to_ref(self) -> 'Ref':
-        ref = Ref(id=self.id, name=self.name)
+    def to_ref(self) -> 'Ref[Actor]':
+        ref: Ref[Actor] = Ref(id=self.id, name=self.name)
         ref.category = self.category
-        ref.model_type = 'Flow'
+        ref.model_type = 'Actor'
         return ref
 
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'Flow':
-        flow = Flow()
-        if v := d.get('@type'):
-            flow.schema_type = v
-        if v := d.get('@id'):
-            flow.id = v
-        if v := d.get('cas'):
-            flow.cas = v
-        if v := d.get('category'):
-            flow.category = v
-        if v := d.get('description'):
-            flow.description = v
-        if v := d.get('flowProperties'):
-            flow.flow_properties = [FlowPropertyFactor.from_dict(e) for e in v]
-        if v := d.get('flowType'):
-            flow.flow_type = v
-        if v := d.get('formula'):
-            flow.formula = v
-        if v := d.get('isInfrastructureFlow'):
-            flow.is_infrastructure_flow = v
-        if v := d.get('lastChange'):
-            flow.last_change = v
-        if v := d.get('library'):
-            flow.library = v
-        if v := d.get('location'):
-            flow.location = Ref[Location].from_dict(v)
-        if v := d.get('name'):
-            flow.name = v
-        if v := d.get('synonyms'):
-            flow.synonyms = v
-        if v := d.get('tags'):
-            flow.tags = v
-        if v := d.get('version'):
-            flow.version = v
-        return flow
-
-    @staticmethod
-    def from_json(data: Union[str, bytes]) -> 'Flow':
-        return Flow.from_dict(json.loads(data))
-
-
-@dataclass
-class FlowResult:
-
-    amount: Optional[float] = None
-    description: Optional[str] = None
-    flow: Optional[Ref] = None
-    flow_property: Optional[Ref] = None
-    is_input: Optional[bool] = None
-    is_ref_flow: Optional[bool] = None
-    location: Optional[Ref] = None
-    unit: Optional[Ref] = None
-
-    def to_dict(self) -> Dict[str, Any]:
-        d: Dict[str, Any] = {}
-        if self.amount:
-            d['amount'] = self.amount
-        if self.description:
-            d['description'] = self.description
-        if self.flow:
-            d['flow'] = self.flow.to_dict()
-        if self.flow_property:
-            d['flowProperty'] = self.flow_property.to_dict()
-        if self.is_input:
-            d['isInput'] = self.is_input
-        if self.is_ref_flow:
-            d['isRefFlow'] = self.is_ref_flow
-        if self.location:
-            d['location'] = self.location.to_dict()
-        if self.unit:
-            d['unit'] = self.unit.to_dict()
-        return d
+    def from_dict(d: Dict[str, Any]) -> 'Actor':
+        actor = Actor()
+        if (v := d.get('@id')) is not None:
+            actor.id = v
+        if (v := d.get('address')) is not None:
+            actor.address = v
+        if (v := d.get('category')) is not None:
+            actor.category = v
+        if (v := d.get('city')) is not None:
+            actor.city = v
+        if (v := d.get('country')) is not None:
+            actor.country = v
+        if (v := d.get('description')) is not None:
+            actor.description = v
+        if (v := d.get('email')) is not None:
+            actor.email = v
+        if (v := d.get('lastChange')) is not None:
+            actor.last_change = v
+        if (v := d.get('library')) is not None:
+            actor.library = v
+        if (v := d.get('name')) is not None:
+            actor.name = v
+        if (v := d.get('tags')) is not None:
+            actor.tags = v
+        if (v := d.get('telefax')) is not None:
+            actor.telefax = v
+        if (v := d.get('telephone')) is not None:
+            actor.telephone = v
+        if (v := d.get('version')) is not None:
+            actor.version = v
+        if (v := d.get('website')) is not None:
+            actor.website = v
+        if (v := d.get('zipCode')) is not None:
+            actor.zip_code = v
+        return actor
 
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'FlowResult':
-        flow_result = FlowResult()
-        if v := d.get('@type'):
-            flow_result.schema_type = v
-        if v := d.get('amount'):
-            flow_result.amount = v
-        if v := d.get('description'):
-            flow_result.description = v
-        if v := d.get('flow'):
-            flow_result.flow = Ref[Flow].from_dict(v)
-        if v := d.get('flowProperty'):
-            flow_result.flow_property = Ref[FlowProperty].from_dict(v)
-        if v := d.get('isInput'):
-            flow_result.is_input = v
-        if v := d.get('isRefFlow'):
-            flow_result.is_ref_flow = v
-        if v := d.get('location'):
-            flow_result.location = Ref[Location].from_dict(v)
-        if v := d.get('unit'):
-            flow_result.unit = Ref[Unit].from_dict(v)
-        return flow_result
-
-
-@dataclass
-class ImpactResult:
-
-    amount: Optional[float] = None
-    description: Optional[str] = None
-    indicator: Optional[Ref] = None
-
-    def to_dict(self) -> Dict[str, Any]:
-        d: Dict[str, Any] = {}
-        if self.amount:
-            d['amount'] = self.amount
-        if self.description:
-            d['description'] = self.description
-        if self.indicator:
-            d['indicator'] = self.indicator.to_dict()
-        return d
+    def from_json(data: Union[str, bytes]) -> 'Actor':
+        return Actor.from_dict(json.loads(data))
 
-    @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'ImpactResult':
-        impact_result = ImpactResult()
-        if v := d.get('@type'):
-            impact_result.schema_type = v
-        if v := d.get('amount'):
-            impact_result.amount = v
-        if v := d.get('description'):
-            impact_result.description = v
-        if v := d.get('indicator'):
-            impact_result.indicator = Ref[ImpactCategory].from_dict(v)
-        return impact_result
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.id is None:
+            errors.append('id: value is required')
+        else:
+            _check_value(self.id, str, 'string', 'id', errors)
+        if self.address is not None:
+            _check_value(self.address, str, 'string', 'address', errors)
+        if self.category is not None:
+            _check_value(self.category, str, 'string', 'category', errors)
+        if self.city is not None:
+            _check_value(self.city, str, 'string', 'city', errors)
+        if self.country is not None:
+            _check_value(self.country, str, 'string', 'country', errors)
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.email is not None:
+            _check_value(self.email, str, 'string', 'email', errors)
+        if self.last_change is not None:
+            _check_value(self.last_change, str, 'dateTime', 'last_change', errors)
+        if self.library is not None:
+            _check_value(self.library, str, 'string', 'library', errors)
+        if self.name is None:
+            errors.append('name: value is required')
+        else:
+            _check_value(self.name, str, 'string', 'name', errors)
+        if self.tags is not None:
+            if _check_value(self.tags, list, 'List[string]', 'tags', errors):
+                for i, e in enumerate(self.tags):
+                    _check_value(e, str, 'string', f'tags[{i}]', errors)
+        if self.telefax is not None:
+            _check_value(self.telefax, str, 'string', 'telefax', errors)
+        if self.telephone is not None:
+            _check_value(self.telephone, str, 'string', 'telephone', errors)
+        if self.version is not None:
+            _check_value(self.version, str, 'string', 'version', errors)
+        if self.website is not None:
+            _check_value(self.website, str, 'string', 'website', errors)
+        if self.zip_code is not None:
+            _check_value(self.zip_code, str, 'string', 'zip_code', errors)
+        return errors
 
 
 @dataclass
-class Location:
-
-    id: Optional[str] = None
+class Category:
+    """A category is used for the categorisation of types like processes,
+    flows, etc. The tricky thing is that the Category class inherits also
+    from the [CategorizedEntity] type so that a category can have a category
+    attribute which is then the parent category of this category (uff).
+    """
+
+    id: Optional[str] = field(default_factory=lambda: str(uuid.uuid4()))
+    """The reference ID (or UUID) of this entity."""
     category: Optional[str] = None
-    code: Optional[str] = None
+    """A full path of the category. Forward slashes are used to separate the
+    segments of this path, e.g. Elementary flows/emissions/air/unspecified.
+    """
     description: Optional[str] = None
-    geometry: Optional[Dict[str, Any]] = None
+    """The description of the entity."""
     last_change: Optional[str] = None
-    latitude: Optional[float] = None
+    """The timestamp when the entity was changed the last time."""
     library: Optional[str] = None
-    longitude: Optional[float] = None
+    """If this entity is part of a library, this field contains the identifier
+    of that library. The identifier is typically just the combination of the
+    library name and version.
+    """
+    model_type: Optional[ModelType] = None
+    """The type of models that can be linked to the category."""
     name: Optional[str] = None
+    """The name of the entity."""
     tags: Optional[List[str]] = None
-    version: Optional[str] = None
-
-    def __post_init__(self):
-        if self.id is None:
-            self.id = str(uuid.uuid4())
+    """A list of optional tags. A tag is just a string which should not contain
+    commas (and other special characters).
+    """
+    version: Optional[str] = '01.00.000'
+    """A version number in MAJOR.MINOR.PATCH format where the MINOR and PATCH
+    fields are optional and the fields may have leading zeros (so 01.00.00
+    is the same as 1.0.0 or 1).
+    """
+
+    def __post_init__(self) -> None:
         if self.version is None:
             self.version = '01.00.000'
         if self.last_change is None:
@@ -1362,169 +2349,293 @@ class Location:
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        d['@type'] = 'Location'
-        if self.id:
+        d['@type'] = 'Category'
+        if self.id is not None:
             d['@id'] = self.id
-        if self.category:
+        if self.category is not None:
             d['category'] = self.category
-        if self.code:
-            d['code'] = self.code
-        if self.description:
+        if self.description is not None:
             d['description'] = self.description
-        if self.geometry:
-            d['geometry'] = self.geometry
-        if self.last_change:
+        if self.last_change is not None:
             d['lastChange'] = self.last_change
-        if self.latitude:
-            d['latitude'] = self.latitude
-        if self.library:
+        if self.library is not None:
             d['library'] = self.library
-        if self.longitude:
-            d['longitude'] = self.longitude
-        if self.name:
+        if self.model_type is not None:
+            d['modelType'] = self.model_type.value
+        if self.name is not None:
             d['name'] = self.name
-        if self.tags:
+        if self.tags is not None:
             d['tags'] = self.tags
-        if self.version:
+        if self.version is not None:
             d['version'] = self.version
         return d
 
     def to_json(self) -> str:
         return json.dumps(self.to_dict(), indent=2)
 
-    def to_ref(self) -> 'Ref':
-        ref = Ref(id=self.id, name=self.name)
+    def to_ref(self) -> 'Ref[Category]':
+        ref: Ref[Category] = Ref(id=self.id, name=self.name)
         ref.category = self.category
-        ref.model_type = 'Location'
+        ref.model_type = 'Category'
         return ref
 
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'Location':
-        location = Location()
-        if v := d.get('@type'):
-            location.schema_type = v
-        if v := d.get('@id'):
-            location.id = v
-        if v := d.get('category'):
-            location.category = v
-        if v := d.get('code'):
-            location.code = v
-        if v := d.get('description'):
-            location.description = v
-        if v := d.get('geometry'):
-            location.geometry = v
-        if v := d.get('lastChange'):
-            location.last_change = v
-        if v := d.get('latitude'):
-            location.latitude = v
-        if v := d.get('library'):
-            location.library = v
-        if v := d.get('longitude'):
-            location.longitude = v
-        if v := d.get('name'):
-            location.name = v
-        if v := d.get('tags'):
-            location.tags = v
-        if v := d.get('version'):
-            location.version = v
-        return location
+    def from_dict(d: Dict[str, Any]) -> 'Category':
+        category = Category()
+        if (v := d.get('@id')) is not None:
+            category.id = v
+        if (v := d.get('category')) is not None:
+            category.category = v
+        if (v := d.get('description')) is not None:
+            category.description = v
+        if (v := d.get('lastChange')) is not None:
+            category.last_change = v
+        if (v := d.get('library')) is not None:
+            category.library = v
+        if (v := d.get('modelType')) is not None:
+            category.model_type = ModelType(v)
+        if (v := d.get('name')) is not None:
+            category.name = v
+        if (v := d.get('tags')) is not None:
+            category.tags = v
+        if (v := d.get('version')) is not None:
+            category.version = v
+        return category
 
     @staticmethod
-    def from_json(data: Union[str, bytes]) -> 'Location':
-        return Location.from_dict(json.loads(data))
-
-
-@dataclass
-class NwFactor:
-
-    impact_category: Optional[Ref] = None
-    normalisation_factor: Optional[float] = None
-    weighting_factor: Optional[float] = None
-
-    def to_dict(self) -> Dict[str, Any]:
-        d: Dict[str, Any] = {}
-        if self.impact_category:
-            d['impactCategory'] = self.impact_category.to_dict()
-        if self.normalisation_factor:
-            d['normalisationFactor'] = self.normalisation_factor
-        if self.weighting_factor:
-            d['weightingFactor'] = self.weighting_factor
-        return d
+    def from_json(data: Union[str, bytes]) -> 'Category':
+        return Category.from_dict(json.loads(data))
 
-    @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'NwFactor':
-        nw_factor = NwFactor()
-        if v := d.get('@type'):
-            nw_factor.schema_type = v
-        if v := d.get('impactCategory'):
-            nw_factor.impact_category = Ref[ImpactCategory].from_dict(v)
-        if v := d.get('normalisationFactor'):
-            nw_factor.normalisation_factor = v
-        if v := d.get('weightingFactor'):
-            nw_factor.weighting_factor = v
-        return nw_factor
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.id is None:
+            errors.append('id: value is required')
+        else:
+            _check_value(self.id, str, 'string', 'id', errors)
+        if self.category is not None:
+            _check_value(self.category, str, 'string', 'category', errors)
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.last_change is not None:
+            _check_value(self.last_change, str, 'dateTime', 'last_change', errors)
+        if self.library is not None:
+            _check_value(self.library, str, 'string', 'library', errors)
+        if self.model_type is None:
+            errors.append('model_type: value is required')
+        else:
+            _check_value(self.model_type, ModelType, 'ModelType', 'model_type', errors)
+        if self.name is None:
+            errors.append('name: value is required')
+        else:
+            _check_value(self.name, str, 'string', 'name', errors)
+        if self.tags is not None:
+            if _check_value(self.tags, list, 'List[string]', 'tags', errors):
+                for i, e in enumerate(self.tags):
+                    _check_value(e, str, 'string', f'tags[{i}]', errors)
+        if self.version is not None:
+            _check_value(self.version, str, 'string', 'version', errors)
+        return errors
 
 
 @dataclass
-class NwSet:
+class Currency:
 
-    id: Optional[str] = None
+    id: Optional[str] = field(default_factory=lambda: str(uuid.uuid4()))
+    """The reference ID (or UUID) of this entity."""
+    category: Optional[str] = None
+    """A full path of the category. Forward slashes are used to separate the
+    segments of this path, e.g. Elementary flows/emissions/air/unspecified.
+    """
+    code: Optional[str] = None
+    conversion_factor: Optional[float] = None
     description: Optional[str] = None
-    factors: Optional[List[NwFactor]] = None
+    """The description of the entity."""
+    last_change: Optional[str] = None
+    """The timestamp when the entity was changed the last time."""
+    library: Optional[str] = None
+    """If this entity is part of a library, this field contains the identifier
+    of that library. The identifier is typically just the combination of the
+    library name and version.
+    """
     name: Optional[str] = None
-    weighted_score_unit: Optional[str] = None
+    """The name of the entity."""
+    ref_currency: Optional[Ref[Currency]] = None
+    """A reference to the currency to which the conversion factor is related.
+    """
+    tags: Optional[List[str]] = None
+    """A list of optional tags. A tag is just a string which should not contain
+    commas (and other special characters).
+    """
+    version: Optional[str] = '01.00.000'
+    """A version number in MAJOR.MINOR.PATCH format where the MINOR and PATCH
+    fields are optional and the fields may have leading zeros (so 01.00.00
+    is the same as 1.0.0 or 1).
+    """
+
+    def __post_init__(self) -> None:
+        if self.version is None:
+            self.version = '01.00.000'
+        if self.last_change is None:
+            self.last_change = datetime.datetime.utcnow().isoformat() + 'Z'
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        if self.id:
+        d['@type'] = 'Currency'
+        if self.id is not None:
             d['@id'] = self.id
-        if self.description:
+        if self.category is not None:
+            d['category'] = self.category
+        if self.code is not None:
+            d['code'] = self.code
+        if self.conversion_factor is not None:
+            d['conversionFactor'] = self.conversion_factor
+        if self.description is not None:
             d['description'] = self.description
-        if self.factors:
-            d['factors'] = [e.to_dict() for e in self.factors]
-        if self.name:
+        if self.last_change is not None:
+            d['lastChange'] = self.last_change
+        if self.library is not None:
+            d['library'] = self.library
+        if self.name is not None:
             d['name'] = self.name
-        if self.weighted_score_unit:
-            d['weightedScoreUnit'] = self.weighted_score_unit
+        if self.ref_currency is not None:
+            d['refCurrency'] = self.ref_currency.to_dict()
+        if self.tags is not None:
+            d['tags'] = self.tags
+        if self.version is not None:
+            d['version'] = self.version
         return d
 
+    def to_json(self) -> str:
+        return json.dumps(self.to_dict(), indent=2)
+
+    def to_ref(self) -> 'Ref[Currency]':
+        ref: Ref[Currency] = Ref(id=self.id, name=self.name)
+        ref.category = self.category
+        ref.model_type = 'Currency'
+        return ref
+
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'NwSet':
-        nw_set = NwSet()
-        if v := d.get('@type'):
-            nw_set.schema_type = v
-        if v := d.get('@id'):
-            nw_set.id = v
-        if v := d.get('description'):
-            nw_set.description = v
-        if v := d.get('factors'):
-            nw_set.factors = [NwFactor.from_dict(e) for e in v]
-        if v := d.get('name'):
-            nw_set.name = v
-        if v := d.get('weightedScoreUnit'):
-            nw_set.weighted_score_unit = v
-        return nw_set
+    def from_dict(d: Dict[str, Any]) -> 'Currency':
+        currency = Currency()
+        if (v := d.get('@id')) is not None:
+            currency.id = v
+        if (v := d.get('category')) is not None:
+            currency.category = v
+        if (v := d.get('code')) is not None:
+            currency.code = v
+        if (v := d.get('conversionFactor')) is not None:
+            currency.conversion_factor = v
+        if (v := d.get('description')) is not None:
+            currency.description = v
+        if (v := d.get('lastChange')) is not None:
+            currency.last_change = v
+        if (v := d.get('library')) is not None:
+            currency.library = v
+        if (v := d.get('name')) is not None:
+            currency.name = v
+        if (v := d.get('refCurrency')) is not None:
+            currency.ref_currency = Ref.from_dict(v, 'Currency')
+        if (v := d.get('tags')) is not None:
+            currency.tags = v
+        if (v := d.get('version')) is not None:
+            currency.version = v
+        return currency
+
+    @staticmethod
+    def from_json(data: Union[str, bytes]) -> 'Currency':
+        return Currency.from_dict(json.loads(data))
 
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.id is None:
+            errors.append('id: value is required')
+        else:
+            _check_value(self.id, str, 'string', 'id', errors)
+        if self.category is not None:
+            _check_value(self.category, str, 'string', 'category', errors)
+        if self.code is not None:
+            _check_value(self.code, str, 'string', 'code', errors)
+        if self.conversion_factor is not None:
+            _check_value(self.conversion_factor, (int, float), 'double', 'conversion_factor', errors)
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.last_change is not None:
+            _check_value(self.last_change, str, 'dateTime', 'last_change', errors)
+        if self.library is not None:
+            _check_value(self.library, str, 'string', 'library', errors)
+        if self.name is None:
+            errors.append('name: value is required')
+        else:
+            _check_value(self.name, str, 'string', 'name', errors)
+        if self.ref_currency is not None:
+            _check_value(self.ref_currency, Ref, 'Ref[Currency]', 'ref_currency', errors)
+        if self.tags is not None:
+            if _check_value(self.tags, list, 'List[string]', 'tags', errors):
+                for i, e in enumerate(self.tags):
+                    _check_value(e, str, 'string', f'tags[{i}]', errors)
+        if self.version is not None:
+            _check_value(self.version, str, 'string', 'version', errors)
+        return errors
 
-@dataclass
-class ImpactMethod:
 
-    id: Optional[str] = None
+@dataclass
+class DQSystem:
+    """A data quality system (DQS) in openLCA describes a pedigree matrix of m
+    data quality indicators (DQIs) and n data quality scores (DQ scores).
+    Such a system can then be used to assess the data quality of processes
+    and exchanges by tagging them with an instance of the system D where D
+    is a m * n matrix with an entry d_ij containing the value of the data
+    quality score j for indicator i. As each indicator in D can only have a
+    single score value, D can be stored in a vector d where d_i contains the
+    data quality score for indicator i. The possible values of the data
+    quality scores are defined as a linear order 1 ... n. In openLCA, the
+    data quality entry d of a process or exchange is stored as a string like
+    (3;2;4;n.a.;2) which means the data quality score for the first
+    indicator is 3, for the second 2 etc. A specific value is n.a. which
+    stands for not applicable. In calculations, these data quality entries
+    can be aggregated in different ways. For example, the data quality entry
+    of a flow f with a contribution of 0.5 kg and a data quality entry of
+    (3;2;4;n.a.;2) in a process p and a contribution of 1.5 kg and a data
+    quality entry of (2;3;1;n.a.;5) in a process q could be aggregated to
+    (2;3;2;n.a.;4) by applying an weighted average and rounding. Finally,
+    custom labels like A, B, C, ... or Very good, Good, Fair, ... for the DQ
+    scores can be assigned by the user. These labels are then displayed
+    instead of 1, 2, 3 ... in the user interface or result exports. However,
+    internally the numeric values are used in the data model and
+    calculations.
+    """
+
+    id: Optional[str] = field(default_factory=lambda: str(uuid.uuid4()))
+    """The reference ID (or UUID) of this entity."""
     category: Optional[str] = None
-    code: Optional[str] = None
+    """A full path of the category. Forward slashes are used to separate the
+    segments of this path, e.g. Elementary flows/emissions/air/unspecified.
+    """
     description: Optional[str] = None
-    impact_categories: Optional[List[Ref]] = None
+    """The description of the entity."""
+    has_uncertainties: Optional[bool] = None
+    indicators: Optional[List[DQIndicator]] = None
     last_change: Optional[str] = None
+    """The timestamp when the entity was changed the last time."""
     library: Optional[str] = None
+    """If this entity is part of a library, this field contains the identifier
+    of that library. The identifier is typically just the combination of the
+    library name and version.
+    """
     name: Optional[str] = None
-    nw_sets: Optional[List[NwSet]] = None
-    source: Optional[Ref] = None
+    """The name of the entity."""
+    source: Optional[Ref[Source]] = None
     tags: Optional[List[str]] = None
-    version: Optional[str] = None
-
-    def __post_init__(self):
-        if self.id is None:
-            self.id = str(uuid.uuid4())
+    """A list of optional tags. A tag is just a string which should not contain
+    commas (and other special characters).
+    """
+    version: Optional[str] = '01.00.000'
+    """A version number in MAJOR.MINOR.PATCH format where the MINOR and PATCH
+    fields are optional and the fields may have leading zeros (so 01.00.00
+    is the same as 1.0.0 or 1).
+    """
+
+    def __post_init__(self) -> None:
         if self.version is None:
             self.version = '01.00.000'
         if self.last_change is None:
@@ -1532,269 +2643,152 @@ class ImpactMethod:
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        d['@type'] = 'ImpactMethod'
-        if self.id:
+        d['@type'] = 'DQSystem'
+        if self.id is not None:
             d['@id'] = self.id
-        if self.category:
+        if self.category is not None:
             d['category'] = self.category
-        if self.code:
-            d['code'] = self.code
-        if self.description:
+        if self.description is not None:
             d['description'] = self.description
-        if self.impact_categories:
-            d['impactCategories'] = [e.to_dict() for e in self.impact_categories]
-        if self.last_change:
+        if self.has_uncertainties is not None:
+            d['hasUncertainties'] = self.has_uncertainties
+        if self.indicators is not None:
+            d['indicators'] = [e.to_dict() for e in self.indicators]
+        if self.last_change is not None:
             d['lastChange'] = self.last_change
-        if self.library:
+        if self.library is not None:
             d['library'] = self.library
-        if self.name:
+        if self.name is not None:
             d['name'] = self.name
-        if self.nw_sets:
-            d['nwSets'] = [e.to_dict() for e in self.nw_sets]
-        if self.source:
+        if self.source is not None:
             d['source'] = self.source.to_dict()
-        if self.tags:
+        if self.tags is not None:
             d['tags'] = self.tags
-        if self.version:
+        if self.version is not None:
             d['version'] = self.version
         return d
 
     def to_json(self) -> str:
         return json.dumps(self.to_dict(), indent=2)
 
-    def to_ref(self) -> 'Ref':
-        ref = Ref(id=self.id, name=self.name)
+    def to_ref(self) -> 'Ref[DQSystem]':
+        ref: Ref[DQSystem] = Ref(id=self.id, name=self.name)
         ref.category = self.category
-        ref.model_type = 'ImpactMethod'
+        ref.model_type = 'DQSystem'
         return ref
 
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'ImpactMethod':
-        impact_method = ImpactMethod()
-        if v := d.get('@type'):
-            impact_method.schema_type = v
-        if v := d.get('@id'):
-            impact_method.id = v
-        if v := d.get('category'):
-            impact_method.category = v
-        if v := d.get('code'):
-            impact_method.code = v
-        if v := d.get('description'):
-            impact_method.description = v
-        if v := d.get('impactCategories'):
-            impact_method.impact_categories = [Ref[ImpactCategory].from_dict(e) for e in v]
-        if v := d.get('lastChange'):
-            impact_method.last_change = v
-        if v := d.get('library'):
-            impact_method.library = v
-        if v := d.get('name'):
-            impact_method.name = v
-        if v := d.get('nwSets'):
-            impact_method.nw_sets = [NwSet.from_dict(e) for e in v]
-        if v := d.get('source'):
-            impact_method.source = Ref[Source].from_dict(v)
-        if v := d.get('tags'):
-            impact_method.tags = v
-        if v := d.get('version'):
-            impact_method.version = v
-        return impact_method
-
-    @staticmethod
-    def from_json(data: Union[str, bytes]) -> 'ImpactMethod':
-        return ImpactMethod.from_dict(json.loads(data))
-
-
-@dataclass
-class ProcessDocumentation:
-
-    completeness_description: Optional[str] = None
-    creation_date: Optional[str] = None
-    data_collection_description: Optional[str] = None
-    data_documentor: Optional[Ref] = None
-    data_generator: Optional[Ref] = None
-    data_selection_description: Optional[str] = None
-    data_set_owner: Optional[Ref] = None
-    data_treatment_description: Optional[str] = None
-    geography_description: Optional[str] = None
-    intended_application: Optional[str] = None
-    inventory_method_description: Optional[str] = None
-    is_copyright_protected: Optional[bool] = None
-    modeling_constants_description: Optional[str] = None
-    project_description: Optional[str] = None
-    publication: Optional[Ref] = None
-    restrictions_description: Optional[str] = None
-    review_details: Optional[str] = None
-    reviewer: Optional[Ref] = None
-    sampling_description: Optional[str] = None
-    sources: Optional[List[Ref]] = None
-    technology_description: Optional[str] = None
-    time_description: Optional[str] = None
-    valid_from: Optional[str] = None
-    valid_until: Optional[str] = None
-
-    def to_dict(self) -> Dict[str, Any]:
-        d: Dict[str, Any] = {}
-        if self.completeness_description:
-            d['completenessDescription'] = self.completeness_description
-        if self.creation_date:
-            d['creationDate'] = self.creation_date
-        if self.data_collection_description:
-            d['dataCollectionDescription'] = self.data_collection_description
-        if self.data_documentor:
-            d['dataDocumentor'] = self.data_documentor.to_dict()
-        if self.data_generator:
-            d['dataGenerator'] = self.data_generator.to_dict()
-        if self.data_selection_description:
-            d['dataSelectionDescription'] = self.data_selection_description
-        if self.data_set_owner:
-            d['dataSetOwner'] = self.data_set_owner.to_dict()
-        if self.data_treatment_description:
-            d['dataTreatmentDescription'] = self.data_treatment_description
-        if self.geography_description:
-            d['geographyDescription'] = self.geography_description
-        if self.intended_application:
-            d['intendedApplication'] = self.intended_application
-        if self.inventory_method_description:
-            d['inventoryMethodDescription'] = self.inventory_method_description
-        if self.is_copyright_protected:
-            d['isCopyrightProtected'] = self.is_copyright_protected
-        if self.modeling_constants_description:
-            d['modelingConstantsDescription'] = self.modeling_constants_description
-        if self.project_description:
-            d['projectDescription'] = self.project_description
-        if self.publication:
-            d['publication'] = self.publication.to_dict()
-        if self.restrictions_description:
-            d['restrictionsDescription'] = self.restrictions_description
-        if self.review_details:
-            d['reviewDetails'] = self.review_details
-        if self.reviewer:
-            d['reviewer'] = self.reviewer.to_dict()
-        if self.sampling_description:
-            d['samplingDescription'] = self.sampling_description
-        if self.sources:
-            d['sources'] = [e.to_dict() for e in self.sources]
-        if self.technology_description:
-            d['technologyDescription'] = self.technology_description
-        if self.time_description:
-            d['timeDescription'] = self.time_description
-        if self.valid_from:
-            d['validFrom'] = self.valid_from
-        if self.valid_until:
-            d['validUntil'] = self.valid_until
-        return d
+    def from_dict(d: Dict[str, Any]) -> 'DQSystem':
+        d_q_system = DQSystem()
+        if (v := d.get('@id')) is not None:
+            d_q_system.id = v
+        if (v := d.get('category')) is not None:
+            d_q_system.category = v
+        if (v := d.get('description')) is not None:
+            d_q_system.description = v
+        if (v := d.get('hasUncertainties')) is not None:
+            d_q_system.has_uncertainties = v
+        if (v := d.get('indicators')) is not None:
+            d_q_system.indicators = [DQIndicator.from_dict(e) for e in v]
+        if (v := d.get('lastChange')) is not None:
+            d_q_system.last_change = v
+        if (v := d.get('library')) is not None:
+            d_q_system.library = v
+        if (v := d.get('name')) is not None:
+            d_q_system.name = v
+        if (v := d.get('source')) is not None:
+            d_q_system.source = Ref.from_dict(v, 'Source')
+        if (v := d.get('tags')) is not None:
+            d_q_system.tags = v
+        if (v := d.get('version')) is not None:
+            d_q_system.version = v
+        return d_q_system
 
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'ProcessDocumentation':
-        process_documentation = ProcessDocumentation()
-        if v := d.get('@type'):
-            process_documentation.schema_type = v
-        if v := d.get('completenessDescription'):
-            process_documentation.completeness_description = v
-        if v := d.get('creationDate'):
-            process_documentation.creation_date = v
-        if v := d.get('dataCollectionDescription'):
-            process_documentation.data_collection_description = v
-        if v := d.get('dataDocumentor'):
-            process_documentation.data_documentor = Ref[Actor].from_dict(v)
-        if v := d.get('dataGenerator'):
-            process_documentation.data_generator = Ref[Actor].from_dict(v)
-        if v := d.get('dataSelectionDescription'):
-            process_documentation.data_selection_description = v
-        if v := d.get('dataSetOwner'):
-            process_documentation.data_set_owner = Ref[Actor].from_dict(v)
-        if v := d.get('dataTreatmentDescription'):
-            process_documentation.data_treatment_description = v
-        if v := d.get('geographyDescription'):
-            process_documentation.geography_description = v
-        if v := d.get('intendedApplication'):
-            process_documentation.intended_application = v
-        if v := d.get('inventoryMethodDescription'):
-            process_documentation.inventory_method_description = v
-        if v := d.get('isCopyrightProtected'):
-            process_documentation.is_copyright_protected = v
-        if v := d.get('modelingConstantsDescription'):
-            process_documentation.modeling_constants_description = v
-        if v := d.get('projectDescription'):
-            process_documentation.project_description = v
-        if v := d.get('publication'):
-            process_documentation.publication = Ref[Source].from_dict(v)
-        if v := d.get('restrictionsDescription'):
-            process_documentation.restrictions_description = v
-        if v := d.get('reviewDetails'):
-            process_documentation.review_details = v
-        if v := d.get('reviewer'):
-            process_documentation.reviewer = Ref[Actor].from_dict(v)
-        if v := d.get('samplingDescription'):
-            process_documentation.sampling_description = v
-        if v := d.get('sources'):
-            process_documentation.sources = [Ref[Source].from_dict(e) for e in v]
-        if v := d.get('technologyDescription'):
-            process_documentation.technology_description = v
-        if v := d.get('timeDescription'):
-            process_documentation.time_description = v
-        if v := d.get('validFrom'):
-            process_documentation.valid_from = v
-        if v := d.get('validUntil'):
-            process_documentation.valid_until = v
-        return process_documentation
-
-
-@dataclass
-class ProcessLink:
-
-    exchange: Optional[ExchangeRef] = None
-    flow: Optional[Ref] = None
-    process: Optional[Ref] = None
-    provider: Optional[Ref] = None
-
-    def to_dict(self) -> Dict[str, Any]:
-        d: Dict[str, Any] = {}
-        if self.exchange:
-            d['exchange'] = self.exchange.to_dict()
-        if self.flow:
-            d['flow'] = self.flow.to_dict()
-        if self.process:
-            d['process'] = self.process.to_dict()
-        if self.provider:
-            d['provider'] = self.provider.to_dict()
-        return d
+    def from_json(data: Union[str, bytes]) -> 'DQSystem':
+        return DQSystem.from_dict(json.loads(data))
 
-    @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'ProcessLink':
-        process_link = ProcessLink()
-        if v := d.get('@type'):
-            process_link.schema_type = v
-        if v := d.get('exchange'):
-            process_link.exchange = ExchangeRef.from_dict(v)
-        if v := d.get('flow'):
-            process_link.flow = Ref[Flow].from_dict(v)
-        if v := d.get('process'):
-            process_link.process = Ref[Process].from_dict(v)
-        if v := d.get('provider'):
-            process_link.provider = Ref.from_dict(v)
-        return process_link
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.id is None:
+            errors.append('id: value is required')
+        else:
+            _check_value(self.id, str, 'string', 'id', errors)
+        if self.category is not None:
+            _check_value(self.category, str, 'string', 'category', errors)
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.has_uncertainties is not None:
+            _check_value(self.has_uncertainties, bool, 'boolean', 'has_uncertainties', errors)
+        if self.indicators is not None:
+            if _check_value(self.indicators, list, 'List[DQIndicator]', 'indicators', errors):
+                for i, e in enumerate(self.indicators):
+                    _check_value(e, DQIndicator, 'DQIndicator', f'indicators[{i}]', errors)
+        if self.last_change is not None:
+            _check_value(self.last_change, str, 'dateTime', 'last_change', errors)
+        if self.library is not None:
+            _check_value(self.library, str, 'string', 'library', errors)
+        if self.name is None:
+            errors.append('name: value is required')
+        else:
+            _check_value(self.name, str, 'string', 'name', errors)
+        if self.source is not None:
+            _check_value(self.source, Ref, 'Ref[Source]', 'source', errors)
+        if self.tags is not None:
+            if _check_value(self.tags, list, 'List[string]', 'tags', errors):
+                for i, e in enumerate(self.tags):
+                    _check_value(e, str, 'string', f'tags[{i}]', errors)
+        if self.version is not None:
+            _check_value(self.version, str, 'string', 'version', errors)
+        return errors
 
 
 @dataclass
-class Result:
+class Epd:
+    """The results and some meta-data of an environmental product declarartion,
+    EPD.
+    """
 
-    id: Optional[str] = None
+    id: Optional[str] = field(default_factory=lambda: str(uuid.uuid4()))
+    """The reference ID (or UUID) of this entity."""
     category: Optional[str] = None
+    """A full path of the category. Forward slashes are used to separate the
+    segments of this path, e.g. Elementary flows/emissions/air/unspecified.
+    """
     description: Optional[str] = None
-    flow_results: Optional[List[FlowResult]] = None
-    impact_method: Optional[Ref] = None
-    impact_results: Optional[List[ImpactResult]] = None
+    """The description of the entity."""
     last_change: Optional[str] = None
+    """The timestamp when the entity was changed the last time."""
     library: Optional[str] = None
+    """If this entity is part of a library, this field contains the identifier
+    of that library. The identifier is typically just the combination of the
+    library name and version.
+    """
+    manufacturer: Optional[Ref[Actor]] = None
+    modules: Optional[List[EpdModule]] = None
+    """The results of this EPD structured in modules."""
     name: Optional[str] = None
-    product_system: Optional[Ref] = None
+    """The name of the entity."""
+    pcr: Optional[Ref[Source]] = None
+    product: Optional[EpdProduct] = None
+    """The declared product of the EPD."""
+    program_operator: Optional[Ref[Actor]] = None
     tags: Optional[List[str]] = None
-    version: Optional[str] = None
-
-    def __post_init__(self):
-        if self.id is None:
-            self.id = str(uuid.uuid4())
+    """A list of optional tags. A tag is just a string which should not contain
+    commas (and other special characters).
+    """
+    urn: Optional[str] = None
+    """An universal resource name (URN) that identifies where the EPD comes
+    from, e.g. openEPD:ec3yznau.
+    """
+    verifier: Optional[Ref[Actor]] = None
+    version: Optional[str] = '01.00.000'
+    """A version number in MAJOR.MINOR.PATCH format where the MINOR and PATCH
+    fields are optional and the fields may have leading zeros (so 01.00.00
+    is the same as 1.0.0 or 1).
+    """
+
+    def __post_init__(self) -> None:
         if self.version is None:
             self.version = '01.00.000'
         if self.last_change is None:
@@ -1802,149 +2796,193 @@ class Result:
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        d['@type'] = 'Result'
-        if self.id:
+        d['@type'] = 'Epd'
+        if self.id is not None:
             d['@id'] = self.id
-        if self.category:
+        if self.category is not None:
             d['category'] = self.category
-        if self.description:
+        if self.description is not None:
             d['description'] = self.description
-        if self.flow_results:
-            d['flowResults'] = [e.to_dict() for e in self.flow_results]
-        if self.impact_method:
-            d['impactMethod'] = self.impact_method.to_dict()
-        if self.impact_results:
-            d['impactResults'] = [e.to_dict() for e in self.impact_results]
-        if self.last_change:
+        if self.last_change is not None:
             d['lastChange'] = self.last_change
-        if self.library:
+        if self.library is not None:
             d['library'] = self.library
-        if self.name:
+        if self.manufacturer is not None:
+            d['manufacturer'] = self.manufacturer.to_dict()
+        if self.modules is not None:
+            d['modules'] = [e.to_dict() for e in self.modules]
+        if self.name is not None:
             d['name'] = self.name
-        if self.product_system:
-            d['productSystem'] = self.product_system.to_dict()
-        if self.tags:
+        if self.pcr is not None:
+            d['pcr'] = self.pcr.to_dict()
+        if self.product is not None:
+            d['product'] = self.product.to_dict()
+        if self.program_operator is not None:
+            d['programOperator'] = self.program_operator.to_dict()
+        if self.tags is not None:
             d['tags'] = self.tags
-        if self.version:
+        if self.urn is not None:
+            d['urn'] = self.urn
+        if self.verifier is not None:
+            d['verifier'] = self.verifier.to_dict()
+        if self.version is not None:
             d['version'] = self.version
         return d
 
     def to_json(self) -> str:
         return json.dumps(self.to_dict(), indent=2)
 
-    def to_ref(self) -> 'Ref':
-        ref = Ref(id=self.id, name=self.name)
+    def to_ref(self) -> 'Ref[Epd]':
+        ref: Ref[Epd] = Ref(id=self.id, name=self.name)
         ref.category = self.category
-        ref.model_type = 'Result'
+        ref.model_type = 'Epd'
         return ref
 
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'Result':
-        result = Result()
-        if v := d.get('@type'):
-            result.schema_type = v
-        if v := d.get('@id'):
-            result.id = v
-        if v := d.get('category'):
-            result.category = v
-        if v := d.get('description'):
-            result.description = v
-        if v := d.get('flowResults'):
-            result.flow_results = [FlowResult.from_dict(e) for e in v]
-        if v := d.get('impactMethod'):
-            result.impact_method = Ref[ImpactMethod].from_dict(v)
-        if v := d.get('impactResults'):
-            result.impact_results = [ImpactResult.from_dict(e) for e in v]
-        if v := d.get('lastChange'):
-            result.last_change = v
-        if v := d.get('library'):
-            result.library = v
-        if v := d.get('name'):
-            result.name = v
-        if v := d.get('productSystem'):
-            result.product_system = Ref[ProductSystem].from_dict(v)
-        if v := d.get('tags'):
-            result.tags = v
-        if v := d.get('version'):
-            result.version = v
-        return result
+    def from_dict(d: Dict[str, Any]) -> 'Epd':
+        epd = Epd()
+        if (v := d.get('@id')) is not None:
+            epd.id = v
+        if (v := d.get('category')) is not None:
+            epd.category = v
+        if (v := d.get('description')) is not None:
+            epd.description = v
+        if (v := d.get('lastChange')) is not None:
+            epd.last_change = v
+        if (v := d.get('library')) is not None:
+            epd.library = v
+        if (v := d.get('manufacturer')) is not None:
+            epd.manufacturer = Ref.from_dict(v, 'Actor')
+        if (v := d.get('modules')) is not None:
+            epd.modules = [EpdModule.from_dict(e) for e in v]
+        if (v := d.get('name')) is not None:
+            epd.name = v
+        if (v := d.get('pcr')) is not None:
+            epd.pcr = Ref.from_dict(v, 'Source')
+        if (v := d.get('product')) is not None:
+            epd.product = EpdProduct.from_dict(v)
+        if (v := d.get('programOperator')) is not None:
+            epd.program_operator = Ref.from_dict(v, 'Actor')
+        if (v := d.get('tags')) is not None:
+            epd.tags = v
+        if (v := d.get('urn')) is not None:
+            epd.urn = v
+        if (v := d.get('verifier')) is not None:
+            epd.verifier = Ref.from_dict(v, 'Actor')
+        if (v := d.get('version')) is not None:
+            epd.version = v
+        return epd
 
     @staticmethod
-    def from_json(data: Union[str, bytes]) -> 'Result':
-        return Result.from_dict(json.loads(data))
-
-
-@dataclass
-class SocialAspect:
-
-    activity_value: Optional[float] = None
-    comment: Optional[str] = None
-    quality: Optional[str] = None
-    raw_amount: Optional[str] = None
-    risk_level: Optional[RiskLevel] = None
-    social_indicator: Optional[Ref] = None
-    source: Optional[Ref] = None
-
-    def to_dict(self) -> Dict[str, Any]:
-        d: Dict[str, Any] = {}
-        if self.activity_value:
-            d['activityValue'] = self.activity_value
-        if self.comment:
-            d['comment'] = self.comment
-        if self.quality:
-            d['quality'] = self.quality
-        if self.raw_amount:
-            d['rawAmount'] = self.raw_amount
-        if self.risk_level:
-            d['riskLevel'] = self.risk_level.value
-        if self.social_indicator:
-            d['socialIndicator'] = self.social_indicator.to_dict()
-        if self.source:
-            d['source'] = self.source.to_dict()
-        return d
+    def from_json(data: Union[str, bytes]) -> 'Epd':
+        return Epd.from_dict(json.loads(data))
 
-    @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'SocialAspect':
-        social_aspect = SocialAspect()
-        if v := d.get('@type'):
-            social_aspect.schema_type = v
-        if v := d.get('activityValue'):
-            social_aspect.activity_value = v
-        if v := d.get('comment'):
-            social_aspect.comment = v
-        if v := d.get('quality'):
-            social_aspect.quality = v
-        if v := d.get('rawAmount'):
-            social_aspect.raw_amount = v
-        if v := d.get('riskLevel'):
-            social_aspect.risk_level = v
-        if v := d.get('socialIndicator'):
-            social_aspect.social_indicator = Ref[SocialIndicator].from_dict(v)
-        if v := d.get('source'):
-            social_aspect.source = Ref[Source].from_dict(v)
-        return social_aspect
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.id is None:
+            errors.append('id: value is required')
+        else:
+            _check_value(self.id, str, 'string', 'id', errors)
+        if self.category is not None:
+            _check_value(self.category, str, 'string', 'category', errors)
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.last_change is not None:
+            _check_value(self.last_change, str, 'dateTime', 'last_change', errors)
+        if self.library is not None:
+            _check_value(self.library, str, 'string', 'library', errors)
+        if self.manufacturer is not None:
+            _check_value(self.manufacturer, Ref, 'Ref[Actor]', 'manufacturer', errors)
+        if self.modules is not None:
+            if _check_value(self.modules, list, 'List[EpdModule]', 'modules', errors):
+                for i, e in enumerate(self.modules):
+                    _check_value(e, EpdModule, 'EpdModule', f'modules[{i}]', errors)
+        if self.name is None:
+            errors.append('name: value is required')
+        else:
+            _check_value(self.name, str, 'string', 'name', errors)
+        if self.pcr is not None:
+            _check_value(self.pcr, Ref, 'Ref[Source]', 'pcr', errors)
+        if self.product is not None:
+            _check_value(self.product, EpdProduct, 'EpdProduct', 'product', errors)
+        if self.program_operator is not None:
+            _check_value(self.program_operator, Ref, 'Ref[Actor]', 'program_operator', errors)
+        if self.tags is not None:
+            if _check_value(self.tags, list, 'List[string]', 'tags', errors):
+                for i, e in enumerate(self.tags):
+                    _check_value(e, str, 'string', f'tags[{i}]', errors)
+        if self.urn is not None:
+            _check_value(self.urn, str, 'string', 'urn', errors)
+        if self.verifier is not None:
+            _check_value(self.verifier, Ref, 'Ref[Actor]', 'verifier', errors)
+        if self.version is not None:
+            _check_value(self.version, str, 'string', 'version', errors)
+        return errors
 
 
 @dataclass
-class SocialIndicator:
+class Flow:
+    """Everything that can be an input or output of a process (e.g. a
+    substance, a product, a waste, a service etc.)
+    """
 
-    id: Optional[str] = None
-    activity_quantity: Optional[Ref] = None
-    activity_unit: Optional[Ref] = None
-    activity_variable: Optional[str] = None
+    id: Optional[str] = field(default_factory=lambda: str(uuid.uuid4()))
+    """The reference ID (or UUID) of this entity."""
+    cas: Optional[str] = None
+    """A CAS number of the flow."""
     category: Optional[str] = None
+    """A full path of the category. Forward slashes are used to separate the
+    segments of this path, e.g. Elementary flows/emissions/air/unspecified.
+    """
     description: Optional[str] = None
-    evaluation_scheme: Optional[str] = None
+    """The description of the entity."""
+    flow_properties: Optional[List[FlowPropertyFactor]] = None
+    """The flow properties (quantities) in which amounts of the flow can be
+    expressed together with conversion factors between these flow flow
+    properties.
+    """
+    flow_type: Optional[FlowType] = None
+    """The type of the flow. Note that this type is more a descriptor of how
+    the flow is handled in calculations.
+    """
+    formula: Optional[str] = None
+    """A chemical formula of the flow."""
+    is_infrastructure_flow: Optional[bool] = None
+    """Indicates whether this flow describes an infrastructure product. This
+    field is part of the openLCA schema because of backward compatibility
+    with EcoSpold 1. It does not really have a meaning in openLCA and should
+    not be used anymore.
+    """
     last_change: Optional[str] = None
+    """The timestamp when the entity was changed the last time."""
     library: Optional[str] = None
+    """If this entity is part of a library, this field contains the identifier
+    of that library. The identifier is typically just the combination of the
+    library name and version.
+    """
+    location: Optional[Ref[Location]] = None
+    """The location of the flow. Normally the location of a flow is defined by
+    the process location where the flow is an input or output. However, some
+    data formats define a location as a property of a flow.
+    """
     name: Optional[str] = None
+    """The name of the entity."""
+    synonyms: Optional[str] = None
+    """A list of synonyms but packed into a single field. Best is to use
+    semicolons as separator as commas are sometimes used in names of
+    chemicals.
+    """
     tags: Optional[List[str]] = None
-    unit_of_measurement: Optional[str] = None
-    version: Optional[str] = None
-
-    def __post_init__(self):
-        if self.id is None:
-            self.id = str(uuid.uuid4())
+    """A list of optional tags. A tag is just a string which should not contain
+    commas (and other special characters).
+    """
+    version: Optional[str] = '01.00.000'
+    """A version number in MAJOR.MINOR.PATCH format where the MINOR and PATCH
+    fields are optional and the fields may have leading zeros (so 01.00.00
+    is the same as 1.0.0 or 1).
+    """
+
+    def __post_init__(self) -> None:
         if self.version is None:
             self.version = '01.00.000'
         if self.last_change is None:
@@ -1952,101 +2990,170 @@ class SocialIndicator:
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        d['@type'] = 'SocialIndicator'
-        if self.id:
-            d['@id'] = self.id
-        if self.activity_quantity:
-            d['activityQuantity'] = self.activity_quantity.to_dict()
-        if self.activity_unit:
-            d['activityUnit'] = self.activity_unit.to_dict()
-        if self.activity_variable:
-            d['activityVariable'] = self.activity_variable
-        if self.category:
+        d['@type'] = 'Flow'
+        if self.id is not None:
+            d['@id'] = self.id
+        if self.cas is not None:
+            d['cas'] = self.cas
+        if self.category is not None:
             d['category'] = self.category
-        if self.description:
+        if self.description is not None:
             d['description'] = self.description
-        if self.evaluation_scheme:
-            d['evaluationScheme'] = self.evaluation_scheme
-        if self.last_change:
+        if self.flow_properties is not None:
+            d['flowProperties'] = [e.to_dict() for e in self.flow_properties]
+        if self.flow_type is not None:
+            d['flowType'] = self.flow_type.value
+        if self.formula is not None:
+            d['formula'] = self.formula
+        if self.is_infrastructure_flow is not None:
+            d['isInfrastructureFlow'] = self.is_infrastructure_flow
+        if self.last_change is not None:
             d['lastChange'] = self.last_change
-        if self.library:
+        if self.library is not None:
             d['library'] = self.library
-        if self.name:
+        if self.location is not None:
+            d['location'] = self.location.to_dict()
+        if self.name is not None:
             d['name'] = self.name
-        if self.tags:
+        if self.synonyms is not None:
+            d['synonyms'] = self.synonyms
+        if self.tags is not None:
             d['tags'] = self.tags
-        if self.unit_of_measurement:
-            d['unitOfMeasurement'] = self.unit_of_measurement
-        if self.version:
+        if self.version is not None:
             d['version'] = self.version
         return d
 
     def to_json(self) -> str:
         return json.dumps(self.to_dict(), indent=2)
 
-    def to_ref(self) -> 'Ref':
-        ref = Ref(id=self.id, name=self.name)
+    def to_ref(self) -> 'Ref[Flow]':
+        ref: Ref[Flow] = Ref(id=self.id, name=self.name)
         ref.category = self.category
-        ref.model_type = 'SocialIndicator'
+        ref.model_type = 'Flow'
         return ref
 
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'SocialIndicator':
-        social_indicator = SocialIndicator()
-        if v := d.get('@type'):
-            social_indicator.schema_type = v
-        if v := d.get('@id'):
-            social_indicator.id = v
-        if v := d.get('activityQuantity'):
-            social_indicator.activity_quantity = Ref[FlowProperty].from_dict(v)
-        if v := d.get('activityUnit'):
-            social_indicator.activity_unit = Ref[Unit].from_dict(v)
-        if v := d.get('activityVariable'):
-            social_indicator.activity_variable = v
-        if v := d.get('category'):
-            social_indicator.category = v
-        if v := d.get('description'):
-            social_indicator.description = v
-        if v := d.get('evaluationScheme'):
-            social_indicator.evaluation_scheme = v
-        if v := d.get('lastChange'):
-            social_indicator.last_change = v
-        if v := d.get('library'):
-            social_indicator.library = v
-        if v := d.get('name'):
-            social_indicator.name = v
-        if v := d.get('tags'):
-            social_indicator.tags = v
-        if v := d.get('unitOfMeasurement'):
-            social_indicator.unit_of_measurement = v
-        if v := d.get('version'):
-            social_indicator.version = v
-        return social_indicator
+    def from_dict(d: Dict[str, Any]) -> 'Flow':
+        flow = Flow()
+        if (v := d.get('@id')) is not None:
+            flow.id = v
+        if (v := d.get('cas')) is not None:
+            flow.cas = v
+        if (v := d.get('category')) is not None:
+            flow.category = v
+        if (v := d.get('description')) is not None:
+            flow.description = v
+        if (v := d.get('flowProperties')) is not None:
+            flow.flow_properties = [FlowPropertyFactor.from_dict(e) for e in v]
+        if (v := d.get('flowType')) is not None:
+            flow.flow_type = FlowType(v)
+        if (v := d.get('formula')) is not None:
+            flow.formula = v
+        if (v := d.get('isInfrastructureFlow')) is not None:
+            flow.is_infrastructure_flow = v
+        if (v := d.get('lastChange')) is not None:
+            flow.last_change = v
+        if (v := d.get('library')) is not None:
+            flow.library = v
+        if (v := d.get('location')) is not None:
+            flow.location = Ref.from_dict(v, 'Location')
+        if (v := d.get('name')) is not None:
+            flow.name = v
+        if (v := d.get('synonyms')) is not None:
+            flow.synonyms = v
+        if (v := d.get('tags')) is not None:
+            flow.tags = v
+        if (v := d.get('version')) is not None:
+            flow.version = v
+        return flow
 
     @staticmethod
-    def from_json(data: Union[str, bytes]) -> 'SocialIndicator':
-        return SocialIndicator.from_dict(json.loads(data))
+    def from_json(data: Union[str, bytes]) -> 'Flow':
+        return Flow.from_dict(json.loads(data))
+
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.id is None:
+            errors.append('id: value is required')
+        else:
+            _check_value(self.id, str, 'string', 'id', errors)
+        if self.cas is not None:
+            _check_value(self.cas, str, 'string', 'cas', errors)
+        if self.category is not None:
+            _check_value(self.category, str, 'string', 'category', errors)
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.flow_properties is not None:
+            if _check_value(self.flow_properties, list, 'List[FlowPropertyFactor]', 'flow_properties', errors):
+                for i, e in enumerate(self.flow_properties):
+                    _check_value(e, FlowPropertyFactor, 'FlowPropertyFactor', f'flow_properties[{i}]', errors)
+        if self.flow_type is not None:
+            _check_value(self.flow_type, FlowType, 'FlowType', 'flow_type', errors)
+        if self.formula is not None:
+            _check_value(self.formula, str, 'string', 'formula', errors)
+        if self.is_infrastructure_flow is not None:
+            _check_value(self.is_infrastructure_flow, bool, 'boolean', 'is_infrastructure_flow', errors)
+        if self.last_change is not None:
+            _check_value(self.last_change, str, 'dateTime', 'last_change', errors)
+        if self.library is not None:
+            _check_value(self.library, str, 'string', 'library', errors)
+        if self.location is not None:
+            _check_value(self.location, Ref, 'Ref[Location]', 'location', errors)
+        if self.name is None:
+            errors.append('name: value is required')
+        else:
+            _check_value(self.name, str, 'string', 'name', errors)
+        if self.synonyms is not None:
+            _check_value(self.synonyms, str, 'string', 'synonyms', errors)
+        if self.tags is not None:
+            if _check_value(self.tags, list, 'List[string]', 'tags', errors):
+                for i, e in enumerate(self.tags):
+                    _check_value(e, str, 'string', f'tags[{i}]', errors)
+        if self.version is not None:
+            _check_value(self.version, str, 'string', 'version', errors)
+        return errors
 
 
 @dataclass
-class Source:
+class FlowMap:
+    """A crosswalk of flows from a source flow list to a target flow list."""
 
-    id: Optional[str] = None
+    id: Optional[str] = field(default_factory=lambda: str(uuid.uuid4()))
+    """The reference ID (or UUID) of this entity."""
     category: Optional[str] = None
+    """A full path of the category. Forward slashes are used to separate the
+    segments of this path, e.g. Elementary flows/emissions/air/unspecified.
+    """
     description: Optional[str] = None
-    external_file: Optional[str] = None
+    """The description of the entity."""
     last_change: Optional[str] = None
+    """The timestamp when the entity was changed the last time."""
     library: Optional[str] = None
+    """If this entity is part of a library, this field contains the identifier
+    of that library. The identifier is typically just the combination of the
+    library name and version.
+    """
+    mappings: Optional[List[FlowMapEntry]] = None
+    """A list of flow mappings from flows in a source flow list to flows in a
+    target flow list.
+    """
     name: Optional[str] = None
+    """The name of the entity."""
+    source: Optional[Ref] = None
+    """The reference (id, name, description) of the source flow list."""
     tags: Optional[List[str]] = None
-    text_reference: Optional[str] = None
-    url: Optional[str] = None
-    version: Optional[str] = None
-    year: Optional[int] = None
-
-    def __post_init__(self):
-        if self.id is None:
-            self.id = str(uuid.uuid4())
+    """A list of optional tags. A tag is just a string which should not contain
+    commas (and other special characters).
+    """
+    target: Optional[Ref] = None
+    """The reference (id, name, description) of the target flow list."""
+    version: Optional[str] = '01.00.000'
+    """A version number in MAJOR.MINOR.PATCH format where the MINOR and PATCH
+    fields are optional and the fields may have leading zeros (so 01.00.00
+    is the same as 1.0.0 or 1).
+    """
+
+    def __post_init__(self) -> None:
         if self.version is None:
             self.version = '01.00.000'
         if self.last_change is None:
@@ -2054,346 +3161,146 @@ class Source:
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        d['@type'] = 'Source'
-        if self.id:
+        d['@type'] = 'FlowMap'
+        if self.id is not None:
             d['@id'] = self.id
-        if self.category:
+        if self.category is not None:
             d['category'] = self.category
-        if self.description:
+        if self.description is not None:
             d['description'] = self.description
-        if self.external_file:
-            d['externalFile'] = self.external_file
-        if self.last_change:
+        if self.last_change is not None:
             d['lastChange'] = self.last_change
-        if self.library:
+        if self.library is not None:
             d['library'] = self.library
-        if self.name:
+        if self.mappings is not None:
+            d['mappings'] = [e.to_dict() for e in self.mappings]
+        if self.name is not None:
             d['name'] = self.name
-        if self.tags:
+        if self.source is not None:
+            d['source'] = self.source.to_dict()
+        if self.tags is not None:
             d['tags'] = self.tags
-        if self.text_reference:
-            d['textReference'] = self.text_reference
-        if self.url:
-            d['url'] = self.url
-        if self.version:
+        if self.target is not None:
+            d['target'] = self.target.to_dict()
+        if self.version is not None:
             d['version'] = self.version
-        if self.year:
-            d['year'] = self.year
         return d
 
     def to_json(self) -> str:
         return json.dumps(self.to_dict(), indent=2)
 
-    def to_ref(self) -> 'Ref':
-        ref = Ref(id=self.id, name=self.name)
+    def to_ref(self) -> 'Ref[FlowMap]':
+        ref: Ref[FlowMap] = Ref(id=self.id, name=self.name)
         ref.category = self.category
-        ref.model_type = 'Source'
+        ref.model_type = 'FlowMap'
         return ref
 
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'Source':
-        source = Source()
-        if v := d.get('@type'):
-            source.schema_type = v
-        if v := d.get('@id'):
-            source.id = v
-        if v := d.get('category'):
-            source.category = v
-        if v := d.get('description'):
-            source.description = v
-        if v := d.get('externalFile'):
-            source.external_file = v
-        if v := d.get('lastChange'):
-            source.last_change = v
-        if v := d.get('library'):
-            source.library = v
-        if v := d.get('name'):
-            source.name = v
-        if v := d.get('tags'):
-            source.tags = v
-        if v := d.get('textReference'):
-            source.text_reference = v
-        if v := d.get('url'):
-            source.url = v
-        if v := d.get('version'):
-            source.version = v
-        if v := d.get('year'):
-            source.year = v
-        return source
-
-    @staticmethod
-    def from_json(data: Union[str, bytes]) -> 'Source':
-        return Source.from_dict(json.loads(data))
-
-
-@dataclass
-class Uncertainty:
-
-    distribution_type: Optional[UncertaintyType] = None
-    geom_mean: Optional[float] = None
-    geom_mean_formula: Optional[str] = None
-    geom_sd: Optional[float] = None
-    geom_sd_formula: Optional[str] = None
-    maximum: Optional[float] = None
-    maximum_formula: Optional[str] = None
-    mean: Optional[float] = None
-    mean_formula: Optional[str] = None
-    minimum: Optional[float] = None
-    minimum_formula: Optional[str] = None
-    mode: Optional[float] = None
-    mode_formula: Optional[str] = None
-    sd: Optional[float] = None
-    sd_formula: Optional[str] = None
-
-    def to_dict(self) -> Dict[str, Any]:
-        d: Dict[str, Any] = {}
-        if self.distribution_type:
-            d['distributionType'] = self.distribution_type.value
-        if self.geom_mean:
-            d['geomMean'] = self.geom_mean
-        if self.geom_mean_formula:
-            d['geomMeanFormula'] = self.geom_mean_formula
-        if self.geom_sd:
-            d['geomSd'] = self.geom_sd
-        if self.geom_sd_formula:
-            d['geomSdFormula'] = self.geom_sd_formula
-        if self.maximum:
-            d['maximum'] = self.maximum
-        if self.maximum_formula:
-            d['maximumFormula'] = self.maximum_formula
-        if self.mean:
-            d['mean'] = self.mean
-        if self.mean_formula:
-            d['meanFormula'] = self.mean_formula
-        if self.minimum:
-            d['minimum'] = self.minimum
-        if self.minimum_formula:
-            d['minimumFormula'] = self.minimum_formula
-        if self.mode:
-            d['mode'] = self.mode
-        if self.mode_formula:
-            d['modeFormula'] = self.mode_formula
-        if self.sd:
-            d['sd'] = self.sd
-        if self.sd_formula:
-            d['sdFormula'] = self.sd_formula
-        return d
-
-    @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'Uncertainty':
-        uncertainty = Uncertainty()
-        if v := d.get('@type'):
-            uncertainty.schema_type = v
-        if v := d.get('distributionType'):
-            uncertainty.distribution_type = v
-        if v := d.get('geomMean'):
-            uncertainty.geom_mean = v
-        if v := d.get('geomMeanFormula'):
-            uncertainty.geom_mean_formula = v
-        if v := d.get('geomSd'):
-            uncertainty.geom_sd = v
-        if v := d.get('geomSdFormula'):
-            uncertainty.geom_sd_formula = v
-        if v := d.get('maximum'):
-            uncertainty.maximum = v
-        if v := d.get('maximumFormula'):
-            uncertainty.maximum_formula = v
-        if v := d.get('mean'):
-            uncertainty.mean = v
-        if v := d.get('meanFormula'):
-            uncertainty.mean_formula = v
-        if v := d.get('minimum'):
-            uncertainty.minimum = v
-        if v := d.get('minimumFormula'):
-            uncertainty.minimum_formula = v
-        if v := d.get('mode'):
-            uncertainty.mode = v
-        if v := d.get('modeFormula'):
-            uncertainty.mode_formula = v
-        if v := d.get('sd'):
-            uncertainty.sd = v
-        if v := d.get('sdFormula'):
-            uncertainty.sd_formula = v
-        return uncertainty
-
-
-@dataclass
-class Exchange:
-
-    amount: Optional[float] = None
-    amount_formula: Optional[str] = None
-    base_uncertainty: Optional[float] = None
-    cost_formula: Optional[str] = None
-    cost_value: Optional[float] = None
-    currency: Optional[Ref] = None
-    default_provider: Optional[Ref] = None
-    description: Optional[str] = None
-    dq_entry: Optional[str] = None
-    flow: Optional[Ref] = None
-    flow_property: Optional[Ref] = None
-    internal_id: Optional[int] = None
-    is_avoided_product: Optional[bool] = None
-    is_input: Optional[bool] = None
-    is_quantitative_reference: Optional[bool] = None
-    location: Optional[Ref] = None
-    uncertainty: Optional[Uncertainty] = None
-    unit: Optional[Ref] = None
-
-    def to_dict(self) -> Dict[str, Any]:
-        d: Dict[str, Any] = {}
-        if self.amount:
-            d['amount'] = self.amount
-        if self.amount_formula:
-            d['amountFormula'] = self.amount_formula
-        if self.base_uncertainty:
-            d['baseUncertainty'] = self.base_uncertainty
-        if self.cost_formula:
-            d['costFormula'] = self.cost_formula
-        if self.cost_value:
-            d['costValue'] = self.cost_value
-        if self.currency:
-            d['currency'] = self.currency.to_dict()
-        if self.default_provider:
-            d['defaultProvider'] = self.default_provider.to_dict()
-        if self.description:
-            d['description'] = self.description
-        if self.dq_entry:
-            d['dqEntry'] = self.dq_entry
-        if self.flow:
-            d['flow'] = self.flow.to_dict()
-        if self.flow_property:
-            d['flowProperty'] = self.flow_property.to_dict()
-        if self.internal_id:
-            d['internalId'] = self.internal_id
-        if self.is_avoided_product:
-            d['isAvoidedProduct'] = self.is_avoided_product
-        if self.is_input:
-            d['isInput'] = self.is_input
-        if self.is_quantitative_reference:
-            d['isQuantitativeReference'] = self.is_quantitative_reference
-        if self.location:
-            d['location'] = self.location.to_dict()
-        if self.uncertainty:
-            d['uncertainty'] = self.uncertainty.to_dict()
-        if self.unit:
-            d['unit'] = self.unit.to_dict()
-        return d
+    def from_dict(d: Dict[str, Any]) -> 'FlowMap':
+        flow_map = FlowMap()
+        if (v := d.get('@id')) is not None:
+            flow_map.id = v
+        if (v := d.get('category')) is not None:
+            flow_map.category = v
+        if (v := d.get('description')) is not None:
+            flow_map.description = v
+        if (v := d.get('lastChange')) is not None:
+            flow_map.last_change = v
+        if (v := d.get('library')) is not None:
+            flow_map.library = v
+        if (v := d.get('mappings')) is not None:
+            flow_map.mappings = [FlowMapEntry.from_dict(e) for e in v]
+        if (v := d.get('name')) is not None:
+            flow_map.name = v
+        if (v := d.get('source')) is not None:
+            flow_map.source = Ref.from_dict(v)
+        if (v := d.get('tags')) is not None:
+            flow_map.tags = v
+        if (v := d.get('target')) is not None:
+            flow_map.target = Ref.from_dict(v)
+        if (v := d.get('version')) is not None:
+            flow_map.version = v
+        return flow_map
 
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'Exchange':
-        exchange = Exchange()
-        if v := d.get('@type'):
-            exchange.schema_type = v
-        if v := d.get('amount'):
-            exchange.amount = v
-        if v := d.get('amountFormula'):
-            exchange.amount_formula = v
-        if v := d.get('baseUncertainty'):
-            exchange.base_uncertainty = v
-        if v := d.get('costFormula'):
-            exchange.cost_formula = v
-        if v := d.get('costValue'):
-            exchange.cost_value = v
-        if v := d.get('currency'):
-            exchange.currency = Ref[Currency].from_dict(v)
-        if v := d.get('defaultProvider'):
-            exchange.default_provider = Ref[Process].from_dict(v)
-        if v := d.get('description'):
-            exchange.description = v
-        if v := d.get('dqEntry'):
-            exchange.dq_entry = v
-        if v := d.get('flow'):
-            exchange.flow = Ref[Flow].from_dict(v)
-        if v := d.get('flowProperty'):
-            exchange.flow_property = Ref[FlowProperty].from_dict(v)
-        if v := d.get('internalId'):
-            exchange.internal_id = v
-        if v := d.get('isAvoidedProduct'):
-            exchange.is_avoided_product = v
-        if v := d.get('isInput'):
-            exchange.is_input = v
-        if v := d.get('isQuantitativeReference'):
-            exchange.is_quantitative_reference = v
-        if v := d.get('location'):
-            exchange.location = Ref[Location].from_dict(v)
-        if v := d.get('uncertainty'):
-            exchange.uncertainty = Uncertainty.from_dict(v)
-        if v := d.get('unit'):
-            exchange.unit = Ref[Unit].from_dict(v)
-        return exchange
-
-
-@dataclass
-class ImpactFactor:
-
-    flow: Optional[Ref] = None
-    flow_property: Optional[Ref] = None
-    formula: Optional[str] = None
-    location: Optional[Ref] = None
-    uncertainty: Optional[Uncertainty] = None
-    unit: Optional[Ref] = None
-    value: Optional[float] = None
-
-    def to_dict(self) -> Dict[str, Any]:
-        d: Dict[str, Any] = {}
-        if self.flow:
-            d['flow'] = self.flow.to_dict()
-        if self.flow_property:
-            d['flowProperty'] = self.flow_property.to_dict()
-        if self.formula:
-            d['formula'] = self.formula
-        if self.location:
-            d['location'] = self.location.to_dict()
-        if self.uncertainty:
-            d['uncertainty'] = self.uncertainty.to_dict()
-        if self.unit:
-            d['unit'] = self.unit.to_dict()
-        if self.value:
-            d['value'] = self.value
-        return d
+    def from_json(data: Union[str, bytes]) -> 'FlowMap':
+        return FlowMap.from_dict(json.loads(data))
 
-    @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'ImpactFactor':
-        impact_factor = ImpactFactor()
-        if v := d.get('@type'):
-            impact_factor.schema_type = v
-        if v := d.get('flow'):
-            impact_factor.flow = Ref[Flow].from_dict(v)
-        if v := d.get('flowProperty'):
-            impact_factor.flow_property = Ref[FlowProperty].from_dict(v)
-        if v := d.get('formula'):
-            impact_factor.formula = v
-        if v := d.get('location'):
-            impact_factor.location = Ref[Location].from_dict(v)
-        if v := d.get('uncertainty'):
-            impact_factor.uncertainty = Uncertainty.from_dict(v)
-        if v := d.get('unit'):
-            impact_factor.unit = Ref[Unit].from_dict(v)
-        if v := d.get('value'):
-            impact_factor.value = v
-        return impact_factor
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.id is None:
+            errors.append('id: value is required')
+        else:
+            _check_value(self.id, str, 'string', 'id', errors)
+        if self.category is not None:
+            _check_value(self.category, str, 'string', 'category', errors)
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.last_change is not None:
+            _check_value(self.last_change, str, 'dateTime', 'last_change', errors)
+        if self.library is not None:
+            _check_value(self.library, str, 'string', 'library', errors)
+        if self.mappings is not None:
+            if _check_value(self.mappings, list, 'List[FlowMapEntry]', 'mappings', errors):
+                for i, e in enumerate(self.mappings):
+                    _check_value(e, FlowMapEntry, 'FlowMapEntry', f'mappings[{i}]', errors)
+        if self.name is None:
+            errors.append('name: value is required')
+        else:
+            _check_value(self.name, str, 'string', 'name', errors)
+        if self.source is not None:
+            _check_value(self.source, Ref, 'Ref', 'source', errors)
+        if self.tags is not None:
+            if _check_value(self.tags, list, 'List[string]', 'tags', errors):
+                for i, e in enumerate(self.tags):
+                    _check_value(e, str, 'string', f'tags[{i}]', errors)
+        if self.target is not None:
+            _check_value(self.target, Ref, 'Ref', 'target', errors)
+        if self.version is not None:
+            _check_value(self.version, str, 'string', 'version', errors)
+        return errors
 
 
 @dataclass
-class Parameter:
+class FlowProperty:
+    """A flow property is a quantity that can be used to express amounts of a
+    flow.
+    """
 
-    id: Optional[str] = None
+    id: Optional[str] = field(default_factory=lambda: str(uuid.uuid4()))
+    """The reference ID (or UUID) of this entity."""
     category: Optional[str] = None
+    """A full path of the category. Forward slashes are used to separate the
+    segments of this path, e.g. Elementary flows/emissions/air/unspecified.
+    """
     description: Optional[str] = None
-    formula: Optional[str] = None
-    is_input_parameter: Optional[bool] = None
+    """The description of the entity."""
+    flow_property_type: Optional[FlowPropertyType] = None
+    """The type of the flow property"""
     last_change: Optional[str] = None
+    """The timestamp when the entity was changed the last time."""
     library: Optional[str] = None
+    """If this entity is part of a library, this field contains the identifier
+    of that library. The identifier is typically just the combination of the
+    library name and version.
+    """
     name: Optional[str] = None
-    parameter_scope: Optional[ParameterScope] = None
+    """The name of the entity."""
     tags: Optional[List[str]] = None
-    uncertainty: Optional[Uncertainty] = None
-    value: Optional[float] = None
-    version: Optional[str] = None
-
-    def __post_init__(self):
-        if self.id is None:
-            self.id = str(uuid.uuid4())
+    """A list of optional tags. A tag is just a string which should not contain
+    commas (and other special characters).
+    """
+    unit_group: Optional[Ref[UnitGroup]] = None
+    """The units of measure that can be used to express quantities of the flow
+    property.
+    """
+    version: Optional[str] = '01.00.000'
+    """A version number in MAJOR.MINOR.PATCH format where the MINOR and PATCH
+    fields are optional and the fields may have leading zeros (so 01.00.00
+    is the same as 1.0.0 or 1).
+    """
+
+    def __post_init__(self) -> None:
         if self.version is None:
             self.version = '01.00.000'
         if self.last_change is None:
@@ -2401,102 +3308,140 @@ class Parameter:
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        d['@type'] = 'Parameter'
-        if self.id:
+        d['@type'] = 'FlowProperty'
+        if self.id is not None:
             d['@id'] = self.id
-        if self.category:
+        if self.category is not None:
             d['category'] = self.category
-        if self.description:
+        if self.description is not None:
             d['description'] = self.description
-        if self.formula:
-            d['formula'] = self.formula
-        if self.is_input_parameter:
-            d['isInputParameter'] = self.is_input_parameter
-        if self.last_change:
+        if self.flow_property_type is not None:
+            d['flowPropertyType'] = self.flow_property_type.value
+        if self.last_change is not None:
             d['lastChange'] = self.last_change
-        if self.library:
+        if self.library is not None:
             d['library'] = self.library
-        if self.name:
+        if self.name is not None:
             d['name'] = self.name
-        if self.parameter_scope:
-            d['parameterScope'] = self.parameter_scope.value
-        if self.tags:
+        if self.tags is not None:
             d['tags'] = self.tags
-        if self.uncertainty:
-            d['uncertainty'] = self.uncertainty.to_dict()
-        if self.value:
-            d['value'] = self.value
-        if self.version:
+        if self.unit_group is not None:
+            d['unitGroup'] = self.unit_group.to_dict()
+        if self.version is not None:
             d['version'] = self.version
         return d
 
     def to_json(self) -> str:
         return json.dumps(self.to_dict(), indent=2)
 
-    def to_ref(self) -> 'Ref':
-        ref = Ref(id=self.id, name=self.name)
+    def to_ref(self) -> 'Ref[FlowProperty]':
+        ref: Ref[FlowProperty] = Ref(id=self.id, name=self.name)
         ref.category = self.category
-        ref.model_type = 'Parameter'
+        ref.model_type = 'FlowProperty'
         return ref
 
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'Parameter':
-        parameter = Parameter()
-        if v := d.get('@type'):
-            parameter.schema_type = v
-        if v := d.get('@id'):
-            parameter.id = v
-        if v := d.get('category'):
-            parameter.category = v
-        if v := d.get('description'):
-            parameter.description = v
-        if v := d.get('formula'):
-            parameter.formula = v
-        if v := d.get('isInputParameter'):
-            parameter.is_input_parameter = v
-        if v := d.get('lastChange'):
-            parameter.last_change = v
-        if v := d.get('library'):
-            parameter.library = v
-        if v := d.get('name'):
-            parameter.name = v
-        if v := d.get('parameterScope'):
-            parameter.parameter_scope = v
-        if v := d.get('tags'):
-            parameter.tags = v
-        if v := d.get('uncertainty'):
-            parameter.uncertainty = Uncertainty.from_dict(v)
-        if v := d.get('value'):
-            parameter.value = v
-        if v := d.get('version'):
-            parameter.version = v
-        return parameter
+    def from_dict(d: Dict[str, Any]) -> 'FlowProperty':
+        flow_property = FlowProperty()
+        if (v := d.get('@id')) is not None:
+            flow_property.id = v
+        if (v := d.get('category')) is not None:
+            flow_property.category = v
+        if (v := d.get('description')) is not None:
+            flow_property.description = v
+        if (v := d.get('flowPropertyType')) is not None:
+            flow_property.flow_property_type = FlowPropertyType(v)
+        if (v := d.get('lastChange')) is not None:
+            flow_property.last_change = v
+        if (v := d.get('library')) is not None:
+            flow_property.library = v
+        if (v := d.get('name')) is not None:
+            flow_property.name = v
+        if (v := d.get('tags')) is not None:
+            flow_property.tags = v
+        if (v := d.get('unitGroup')) is not None:
+            flow_property.unit_group = Ref.from_dict(v, 'UnitGroup')
+        if (v := d.get('version')) is not None:
+            flow_property.version = v
+        return flow_property
 
     @staticmethod
-    def from_json(data: Union[str, bytes]) -> 'Parameter':
-        return Parameter.from_dict(json.loads(data))
+    def from_json(data: Union[str, bytes]) -> 'FlowProperty':
+        return FlowProperty.from_dict(json.loads(data))
+
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.id is None:
+            errors.append('id: value is required')
+        else:
+            _check_value(self.id, str, 'string', 'id', errors)
+        if self.category is not None:
+            _check_value(self.category, str, 'string', 'category', errors)
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.flow_property_type is not None:
+            _check_value(self.flow_property_type, FlowPropertyType, 'FlowPropertyType', 'flow_property_type', errors)
+        if self.last_change is not None:
+            _check_value(self.last_change, str, 'dateTime', 'last_change', errors)
+        if self.library is not None:
+            _check_value(self.library, str, 'string', 'library', errors)
+        if self.name is None:
+            errors.append('name: value is required')
+        else:
+            _check_value(self.name, str, 'string', 'name', errors)
+        if self.tags is not None:
+            if _check_value(self.tags, list, 'List[string]', 'tags', errors):
+                for i, e in enumerate(self.tags):
+                    _check_value(e, str, 'string', f'tags[{i}]', errors)
+        if self.unit_group is not None:
+            _check_value(self.unit_group, Ref, 'Ref[UnitGroup]', 'unit_group', errors)
+        if self.version is not None:
+            _check_value(self.version, str, 'string', 'version', errors)
+        return errors
 
 
 @dataclass
 class ImpactCategory:
 
-    id: Optional[str] = None
+    id: Optional[str] = field(default_factory=lambda: str(uuid.uuid4()))
+    """The reference ID (or UUID) of this entity."""
     category: Optional[str] = None
+    """A full path of the category. Forward slashes are used to separate the
+    segments of this path, e.g. Elementary flows/emissions/air/unspecified.
+    """
     code: Optional[str] = None
     description: Optional[str] = None
+    """The description of the entity."""
     impact_factors: Optional[List[ImpactFactor]] = None
+    """The characterisation factors of the LCIA category."""
     last_change: Optional[str] = None
+    """The timestamp when the entity was changed the last time."""
     library: Optional[str] = None
+    """If this entity is part of a library, this field contains the identifier
+    of that library. The identifier is typically just the combination of the
+    library name and version.
+    """
     name: Optional[str] = None
+    """The name of the entity."""
     parameters: Optional[List[Parameter]] = None
+    """A set of parameters which can be used in formulas of the
+    characterisation factors in this impact category.
+    """
     ref_unit: Optional[str] = None
-    source: Optional[Ref] = None
+    """The name of the reference unit of the LCIA category (e.g. kg CO2-eq.).
+    """
+    source: Optional[Ref[Source]] = None
     tags: Optional[List[str]] = None
-    version: Optional[str] = None
-
-    def __post_init__(self):
-        if self.id is None:
-            self.id = str(uuid.uuid4())
+    """A list of optional tags. A tag is just a string which should not contain
+    commas (and other special characters).
+    """
+    version: Optional[str] = '01.00.000'
+    """A version number in MAJOR.MINOR.PATCH format where the MINOR and PATCH
+    fields are optional and the fields may have leading zeros (so 01.00.00
+    is the same as 1.0.0 or 1).
+    """
+
+    def __post_init__(self) -> None:
         if self.version is None:
             self.version = '01.00.000'
         if self.last_change is None:
@@ -2505,39 +3450,39 @@ class ImpactCategory:
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
         d['@type'] = 'ImpactCategory'
-        if self.id:
+        if self.id is not None:
             d['@id'] = self.id
-        if self.category:
+        if self.category is not None:
             d['category'] = self.category
-        if self.code:
+        if self.code is not None:
             d['code'] = self.code
-        if self.description:
+        if self.description is not None:
             d['description'] = self.description
-        if self.impact_factors:
+        if self.impact_factors is not None:
             d['impactFactors'] = [e.to_dict() for e in self.impact_factors]
-        if self.last_change:
+        if self.last_change is not None:
             d['lastChange'] = self.last_change
-        if self.library:
+        if self.library is not None:
             d['library'] = self.library
-        if self.name:
+        if self.name is not None:
             d['name'] = self.name
-        if self.parameters:
+        if self.parameters is not None:
             d['parameters'] = [e.to_dict() for e in self.parameters]
-        if self.ref_unit:
+        if self.ref_unit is not None:
             d['refUnit'] = self.ref_unit
-        if self.source:
+        if self.source is not None:
             d['source'] = self.source.to_dict()
-        if self.tags:
+        if self.tags is not None:
             d['tags'] = self.tags
-        if self.version:
+        if self.version is not None:
             d['version'] = self.version
         return d
 
     def to_json(self) -> str:
         return json.dumps(self.to_dict(), indent=2)
 
-    def to_ref(self) -> 'Ref':
-        ref = Ref(id=self.id, name=self.name)
+    def to_ref(self) -> 'Ref[ImpactCategory]':
+        ref: Ref[ImpactCategory] = Ref(id=self.id, name=self.name)
         ref.category = self.category
         ref.model_type = 'ImpactCategory'
         return ref
@@ -2545,33 +3490,31 @@ class ImpactCategory:
     @staticmethod
     def from_dict(d: Dict[str, Any]) -> 'ImpactCategory':
         impact_category = ImpactCategory()
-        if v := d.get('@type'):
-            impact_category.schema_type = v
-        if v := d.get('@id'):
+        if (v := d.get('@id')) is not None:
             impact_category.id = v
-        if v := d.get('category'):
+        if (v := d.get('category')) is not None:
             impact_category.category = v
-        if v := d.get('code'):
+        if (v := d.get('code')) is not None:
             impact_category.code = v
-        if v := d.get('description'):
+        if (v := d.get('description')) is not None:
             impact_category.description = v
-        if v := d.get('impactFactors'):
+        if (v := d.get('impactFactors')) is not None:
             impact_category.impact_factors = [ImpactFactor.from_dict(e) for e in v]
-        if v := d.get('lastChange'):
+        if (v := d.get('lastChange')) is not None:
             impact_category.last_change = v
-        if v := d.get('library'):
+        if (v := d.get('library')) is not None:
             impact_category.library = v
-        if v := d.get('name'):
+        if (v := d.get('name')) is not None:
             impact_category.name = v
-        if v := d.get('parameters'):
+        if (v := d.get('parameters')) is not None:
             impact_category.parameters = [Parameter.from_dict(e) for e in v]
-        if v := d.get('refUnit'):
+        if (v := d.get('refUnit')) is not None:
             impact_category.ref_unit = v
-        if v := d.get('source'):
-            impact_category.source = Ref[Source].from_dict(v)
-        if v := d.get('tags'):
+        if (v := d.get('source')) is not None:
+            impact_category.source = Ref.from_dict(v, 'Source')
+        if (v := d.get('tags')) is not None:
             impact_category.tags = v
-        if v := d.get('version'):
+        if (v := d.get('version')) is not None:
             impact_category.version = v
         return impact_category
 
@@ -2579,194 +3522,603 @@ class ImpactCategory:
     def from_json(data: Union[str, bytes]) -> 'ImpactCategory':
         return ImpactCategory.from_dict(json.loads(data))
 
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.id is None:
+            errors.append('id: value is required')
+        else:
+            _check_value(self.id, str, 'string', 'id', errors)
+        if self.category is not None:
+            _check_value(self.category, str, 'string', 'category', errors)
+        if self.code is not None:
+            _check_value(self.code, str, 'string', 'code', errors)
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.impact_factors is not None:
+            if _check_value(self.impact_factors, list, 'List[ImpactFactor]', 'impact_factors', errors):
+                for i, e in enumerate(self.impact_factors):
+                    _check_value(e, ImpactFactor, 'ImpactFactor', f'impact_factors[{i}]', errors)
+        if self.last_change is not None:
+            _check_value(self.last_change, str, 'dateTime', 'last_change', errors)
+        if self.library is not None:
+            _check_value(self.library, str, 'string', 'library', errors)
+        if self.name is None:
+            errors.append('name: value is required')
+        else:
+            _check_value(self.name, str, 'string', 'name', errors)
+        if self.parameters is not None:
+            if _check_value(self.parameters, list, 'List[Parameter]', 'parameters', errors):
+                for i, e in enumerate(self.parameters):
+                    _check_value(e, Parameter, 'Parameter', f'parameters[{i}]', errors)
+        if self.ref_unit is not None:
+            _check_value(self.ref_unit, str, 'string', 'ref_unit', errors)
+        if self.source is not None:
+            _check_value(self.source, Ref, 'Ref[Source]', 'source', errors)
+        if self.tags is not None:
+            if _check_value(self.tags, list, 'List[string]', 'tags', errors):
+                for i, e in enumerate(self.tags):
+                    _check_value(e, str, 'string', f'tags[{i}]', errors)
+        if self.version is not None:
+            _check_value(self.version, str, 'string', 'version', errors)
+        return errors
+
 
 @dataclass
-class ParameterRedef:
+class ImpactMethod:
+    """An impact assessment method."""
 
-    context: Optional[Ref] = None
+    id: Optional[str] = field(default_factory=lambda: str(uuid.uuid4()))
+    """The reference ID (or UUID) of this entity."""
+    category: Optional[str] = None
+    """A full path of the category. Forward slashes are used to separate the
+    segments of this path, e.g. Elementary flows/emissions/air/unspecified.
+    """
+    code: Optional[str] = None
+    """The code of the impact assessment method."""
     description: Optional[str] = None
-    is_protected: Optional[bool] = None
+    """The description of the entity."""
+    impact_categories: Optional[List[Ref[ImpactCategory]]] = None
+    """The impact categories of the method."""
+    last_change: Optional[str] = None
+    """The timestamp when the entity was changed the last time."""
+    library: Optional[str] = None
+    """If this entity is part of a library, this field contains the identifier
+    of that library. The identifier is typically just the combination of the
+    library name and version.
+    """
     name: Optional[str] = None
-    uncertainty: Optional[Uncertainty] = None
-    value: Optional[float] = None
+    """The name of the entity."""
+    nw_sets: Optional[List[NwSet]] = None
+    """The normalization and weighting sets of the method."""
+    source: Optional[Ref[Source]] = None
+    tags: Optional[List[str]] = None
+    """A list of optional tags. A tag is just a string which should not contain
+    commas (and other special characters).
+    """
+    version: Optional[str] = '01.00.000'
+    """A version number in MAJOR.MINOR.PATCH format where the MINOR and PATCH
+    fields are optional and the fields may have leading zeros (so 01.00.00
+    is the same as 1.0.0 or 1).
+    """
+
+    def __post_init__(self) -> None:
+        if self.version is None:
+            self.version = '01.00.000'
+        if self.last_change is None:
+            self.last_change = datetime.datetime.utcnow().isoformat() + 'Z'
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        if self.context:
-            d['context'] = self.context.to_dict()
-        if self.description:
+        d['@type'] = 'ImpactMethod'
+        if self.id is not None:
+            d['@id'] = self.id
+        if self.category is not None:
+            d['category'] = self.category
+        if self.code is not None:
+            d['code'] = self.code
+        if self.description is not None:
             d['description'] = self.description
-        if self.is_protected:
-            d['isProtected'] = self.is_protected
-        if self.name:
+        if self.impact_categories is not None:
+            d['impactCategories'] = [e.to_dict() for e in self.impact_categories]
+        if self.last_change is not None:
+            d['lastChange'] = self.last_change
+        if self.library is not None:
+            d['library'] = self.library
+        if self.name is not None:
             d['name'] = self.name
-        if self.uncertainty:
-            d['uncertainty'] = self.uncertainty.to_dict()
-        if self.value:
-            d['value'] = self.value
+        if self.nw_sets is not None:
+            d['nwSets'] = [e.to_dict() for e in self.nw_sets]
+        if self.source is not None:
+            d['source'] = self.source.to_dict()
+        if self.tags is not None:
+            d['tags'] = self.tags
+        if self.version is not None:
+            d['version'] = self.version
         return d
 
+    def to_json(self) -> str:
+        return json.dumps(self.to_dict(), indent=2)
+
+    def to_ref(self) -> 'Ref[ImpactMethod]':
+        ref: Ref[ImpactMethod] = Ref(id=self.id, name=self.name)
+        ref.category = self.category
+        ref.model_type = 'ImpactMethod'
+        return ref
+
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'ParameterRedef':
-        parameter_redef = ParameterRedef()
-        if v := d.get('@type'):
-            parameter_redef.schema_type = v
-        if v := d.get('context'):
-            parameter_redef.context = Ref.from_dict(v)
-        if v := d.get('description'):
-            parameter_redef.description = v
-        if v := d.get('isProtected'):
-            parameter_redef.is_protected = v
-        if v := d.get('name'):
-            parameter_redef.name = v
-        if v := d.get('uncertainty'):
-            parameter_redef.uncertainty = Uncertainty.from_dict(v)
-        if v := d.get('value'):
-            parameter_redef.value = v
-        return parameter_redef
+    def from_dict(d: Dict[str, Any]) -> 'ImpactMethod':
+        impact_method = ImpactMethod()
+        if (v := d.get('@id')) is not None:
+            impact_method.id = v
+        if (v := d.get('category')) is not None:
+            impact_method.category = v
+        if (v := d.get('code')) is not None:
+            impact_method.code = v
+        if (v := d.get('description')) is not None:
+            impact_method.description = v
+        if (v := d.get('impactCategories')) is not None:
+            impact_method.impact_categories = [Ref.from_dict(e, 'ImpactCategory') for e in v]
+        if (v := d.get('lastChange')) is not None:
+            impact_method.last_change = v
+        if (v := d.get('library')) is not None:
+            impact_method.library = v
+        if (v := d.get('name')) is not None:
+            impact_method.name = v
+        if (v := d.get('nwSets')) is not None:
+            impact_method.nw_sets = [NwSet.from_dict(e) for e in v]
+        if (v := d.get('source')) is not None:
+            impact_method.source = Ref.from_dict(v, 'Source')
+        if (v := d.get('tags')) is not None:
+            impact_method.tags = v
+        if (v := d.get('version')) is not None:
+            impact_method.version = v
+        return impact_method
+
+    @staticmethod
+    def from_json(data: Union[str, bytes]) -> 'ImpactMethod':
+        return ImpactMethod.from_dict(json.loads(data))
+
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.id is None:
+            errors.append('id: value is required')
+        else:
+            _check_value(self.id, str, 'string', 'id', errors)
+        if self.category is not None:
+            _check_value(self.category, str, 'string', 'category', errors)
+        if self.code is not None:
+            _check_value(self.code, str, 'string', 'code', errors)
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.impact_categories is not None:
+            if _check_value(self.impact_categories, list, 'List[Ref[ImpactCategory]]', 'impact_categories', errors):
+                for i, e in enumerate(self.impact_categories):
+                    _check_value(e, Ref, 'Ref[ImpactCategory]', f'impact_categories[{i}]', errors)
+        if self.last_change is not None:
+            _check_value(self.last_change, str, 'dateTime', 'last_change', errors)
+        if self.library is not None:
+            _check_value(self.library, str, 'string', 'library', errors)
+        if self.name is None:
+            errors.append('name: value is required')
+        else:
+            _check_value(self.name, str, 'string', 'name', errors)
+        if self.nw_sets is not None:
+            if _check_value(self.nw_sets, list, 'List[NwSet]', 'nw_sets', errors):
+                for i, e in enumerate(self.nw_sets):
+                    _check_value(e, NwSet, 'NwSet', f'nw_sets[{i}]', errors)
+        if self.source is not None:
+            _check_value(self.source, Ref, 'Ref[Source]', 'source', errors)
+        if self.tags is not None:
+            if _check_value(self.tags, list, 'List[string]', 'tags', errors):
+                for i, e in enumerate(self.tags):
+                    _check_value(e, str, 'string', f'tags[{i}]', errors)
+        if self.version is not None:
+            _check_value(self.version, str, 'string', 'version', errors)
+        return errors
 
 
 @dataclass
-class CalculationSetup:
+class Location:
+    """A location like a country, state, city, etc."""
 
-    allocation: Optional[AllocationType] = None
-    amount: Optional[float] = None
-    calculation_type: Optional[CalculationType] = None
-    flow_property: Optional[Ref] = None
-    impact_method: Optional[Ref] = None
-    number_of_runs: Optional[int] = None
-    nw_set: Optional[Ref] = None
-    parameters: Optional[List[ParameterRedef]] = None
-    target: Optional[Ref] = None
-    unit: Optional[Ref] = None
-    with_costs: Optional[bool] = None
-    with_regionalization: Optional[bool] = None
+    id: Optional[str] = field(default_factory=lambda: str(uuid.uuid4()))
+    """The reference ID (or UUID) of this entity."""
+    category: Optional[str] = None
+    """A full path of the category. Forward slashes are used to separate the
+    segments of this path, e.g. Elementary flows/emissions/air/unspecified.
+    """
+    code: Optional[str] = None
+    """The code of the location (e.g. an ISO 2-letter country code)."""
+    description: Optional[str] = None
+    """The description of the entity."""
+    geometry: Optional[Dict[str, Any]] = None
+    """A GeoJSON object."""
+    last_change: Optional[str] = None
+    """The timestamp when the entity was changed the last time."""
+    latitude: Optional[float] = None
+    """The average latitude of the location."""
+    library: Optional[str] = None
+    """If this entity is part of a library, this field contains the identifier
+    of that library. The identifier is typically just the combination of the
+    library name and version.
+    """
+    longitude: Optional[float] = None
+    """The average longitude of the location."""
+    name: Optional[str] = None
+    """The name of the entity."""
+    tags: Optional[List[str]] = None
+    """A list of optional tags. A tag is just a string which should not contain
+    commas (and other special characters).
+    """
+    version: Optional[str] = '01.00.000'
+    """A version number in MAJOR.MINOR.PATCH format where the MINOR and PATCH
+    fields are optional and the fields may have leading zeros (so 01.00.00
+    is the same as 1.0.0 or 1).
+    """
+
+    def __post_init__(self) -> None:
+        if self.version is None:
+            self.version = '01.00.000'
+        if self.last_change is None:
+            self.last_change = datetime.datetime.utcnow().isoformat() + 'Z'
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        if self.allocation:
-            d['allocation'] = self.allocation.value
-        if self.amount:
-            d['amount'] = self.amount
-        if self.calculation_type:
-            d['calculationType'] = self.calculation_type.value
-        if self.flow_property:
-            d['flowProperty'] = self.flow_property.to_dict()
-        if self.impact_method:
-            d['impactMethod'] = self.impact_method.to_dict()
-        if self.number_of_runs:
-            d['numberOfRuns'] = self.number_of_runs
-        if self.nw_set:
-            d['nwSet'] = self.nw_set.to_dict()
-        if self.parameters:
-            d['parameters'] = [e.to_dict() for e in self.parameters]
-        if self.target:
-            d['target'] = self.target.to_dict()
-        if self.unit:
-            d['unit'] = self.unit.to_dict()
-        if self.with_costs:
-            d['withCosts'] = self.with_costs
-        if self.with_regionalization:
-            d['withRegionalization'] = self.with_regionalization
+        d['@type'] = 'Location'
+        if self.id is not None:
+            d['@id'] = self.id
+        if self.category is not None:
+            d['category'] = self.category
+        if self.code is not None:
+            d['code'] = self.code
+        if self.description is not None:
+            d['description'] = self.description
+        if self.geometry is not None:
+            d['geometry'] = self.geometry
+        if self.last_change is not None:
+            d['lastChange'] = self.last_change
+        if self.latitude is not None:
+            d['latitude'] = self.latitude
+        if self.library is not None:
+            d['library'] = self.library
+        if self.longitude is not None:
+            d['longitude'] = self.longitude
+        if self.name is not None:
+            d['name'] = self.name
+        if self.tags is not None:
+            d['tags'] = self.tags
+        if self.version is not None:
+            d['version'] = self.version
         return d
 
+    def to_json(self) -> str:
+        return json.dumps(self.to_dict(), indent=2)
+
+    def to_ref(self) -> 'Ref[Location]':
+        ref: Ref[Location] = Ref(id=self.id, name=self.name)
+        ref.category = self.category
+        ref.model_type = 'Location'
+        return ref
+
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'CalculationSetup':
-        calculation_setup = CalculationSetup()
-        if v := d.get('@type'):
-            calculation_setup.schema_type = v
-        if v := d.get('allocation'):
-            calculation_setup.allocation = v
-        if v := d.get('amount'):
-            calculation_setup.amount = v
-        if v := d.get('calculationType'):
-            calculation_setup.calculation_type = v
-        if v := d.get('flowProperty'):
-            calculation_setup.flow_property = Ref[FlowProperty].from_dict(v)
-        if v := d.get('impactMethod'):
-            calculation_setup.impact_method = Ref[ImpactMethod].from_dict(v)
-        if v := d.get('numberOfRuns'):
-            calculation_setup.number_of_runs = v
-        if v := d.get('nwSet'):
-            calculation_setup.nw_set = Ref[NwSet].from_dict(v)
-        if v := d.get('parameters'):
-            calculation_setup.parameters = [ParameterRedef.from_dict(e) for e in v]
-        if v := d.get('target'):
-            calculation_setup.target = Ref.from_dict(v)
-        if v := d.get('unit'):
-            calculation_setup.unit = Ref[Unit].from_dict(v)
-        if v := d.get('withCosts'):
-            calculation_setup.with_costs = v
-        if v := d.get('withRegionalization'):
-            calculation_setup.with_regionalization = v
-        return calculation_setup
+    def from_dict(d: Dict[str, Any]) -> 'Location':
+        location = Location()
+        if (v := d.get('@id')) is not None:
+            location.id = v
+        if (v := d.get('category')) is not None:
+            location.category = v
+        if (v := d.get('code')) is not None:
+            location.code = v
+        if (v := d.get('description')) is not None:
+            location.description = v
+        if (v := d.get('geometry')) is not None:
+            location.geometry = v
+        if (v := d.get('lastChange')) is not None:
+            location.last_change = v
+        if (v := d.get('latitude')) is not None:
+            location.latitude = v
+        if (v := d.get('library')) is not None:
+            location.library = v
+        if (v := d.get('longitude')) is not None:
+            location.longitude = v
+        if (v := d.get('name')) is not None:
+            location.name = v
+        if (v := d.get('tags')) is not None:
+            location.tags = v
+        if (v := d.get('version')) is not None:
+            location.version = v
+        return location
 
+    @staticmethod
+    def from_json(data: Union[str, bytes]) -> 'Location':
+        return Location.from_dict(json.loads(data))
+
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.id is None:
+            errors.append('id: value is required')
+        else:
+            _check_value(self.id, str, 'string', 'id', errors)
+        if self.category is not None:
+            _check_value(self.category, str, 'string', 'category', errors)
+        if self.code is not None:
+            _check_value(self.code, str, 'string', 'code', errors)
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.geometry is not None:
+            _check_value(self.geometry, Dict[str, Any], 'GeoJSON', 'geometry', errors)
+        if self.last_change is not None:
+            _check_value(self.last_change, str, 'dateTime', 'last_change', errors)
+        if self.latitude is not None:
+            _check_value(self.latitude, (int, float), 'double', 'latitude', errors)
+        if self.library is not None:
+            _check_value(self.library, str, 'string', 'library', errors)
+        if self.longitude is not None:
+            _check_value(self.longitude, (int, float), 'double', 'longitude', errors)
+        if self.name is None:
+            errors.append('name: value is required')
+        else:
+            _check_value(self.name, str, 'string', 'name', errors)
+        if self.tags is not None:
+            if _check_value(self.tags, list, 'List[string]', 'tags', errors):
+                for i, e in enumerate(self.tags):
+                    _check_value(e, str, 'string', f'tags[{i}]', errors)
+        if self.version is not None:
+            _check_value(self.version, str, 'string', 'version', errors)
+        return errors
 
-@dataclass
-class ParameterRedefSet:
 
+@dataclass
+class Parameter:
+    """In openLCA, parameters can be defined in different scopes: global,
+    process, or LCIA method. The parameter name can be used in formulas and,
+    thus, need to conform to a specific syntax. Within a scope the parameter
+    name should be unique (otherwise the evaluation is not deterministic).
+    There are two types of parameters in openLCA: input parameters and
+    dependent parameters. An input parameter can have an optional
+    uncertainty distribution but not a formula. A dependent parameter can
+    (should) have a formula (where also other parameters can be used) but no
+    uncertainty distribution.
+    """
+
+    id: Optional[str] = field(default_factory=lambda: str(uuid.uuid4()))
+    """The reference ID (or UUID) of this entity."""
+    category: Optional[str] = None
+    """A full path of the category. Forward slashes are used to separate the
+    segments of this path, e.g. Elementary flows/emissions/air/unspecified.
+    """
     description: Optional[str] = None
-    is_baseline: Optional[bool] = None
+    """The description of the entity."""
+    formula: Optional[str] = None
+    """A mathematical expression to calculate the parameter value."""
+    is_input_parameter: Optional[bool] = None
+    """Indicates whether the parameter is an input parameter (true) or a
+    dependent/calculated parameter (false). A parameter can have a formula
+    if it is not an input parameter.
+    """
+    last_change: Optional[str] = None
+    """The timestamp when the entity was changed the last time."""
+    library: Optional[str] = None
+    """If this entity is part of a library, this field contains the identifier
+    of that library. The identifier is typically just the combination of the
+    library name and version.
+    """
     name: Optional[str] = None
-    parameters: Optional[List[ParameterRedef]] = None
+    """The name of the entity."""
+    parameter_scope: Optional[ParameterScope] = None
+    """The scope where the parameter is valid."""
+    tags: Optional[List[str]] = None
+    """A list of optional tags. A tag is just a string which should not contain
+    commas (and other special characters).
+    """
+    uncertainty: Optional[Uncertainty] = None
+    """An uncertainty distribution of the parameter value. This is only valid
+    for input parameters.
+    """
+    value: Optional[float] = None
+    """The parameter value."""
+    version: Optional[str] = '01.00.000'
+    """A version number in MAJOR.MINOR.PATCH format where the MINOR and PATCH
+    fields are optional and the fields may have leading zeros (so 01.00.00
+    is the same as 1.0.0 or 1).
+    """
+
+    def __post_init__(self) -> None:
+        if self.version is None:
+            self.version = '01.00.000'
+        if self.last_change is None:
+            self.last_change = datetime.datetime.utcnow().isoformat() + 'Z'
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        if self.description:
+        d['@type'] = 'Parameter'
+        if self.id is not None:
+            d['@id'] = self.id
+        if self.category is not None:
+            d['category'] = self.category
+        if self.description is not None:
             d['description'] = self.description
-        if self.is_baseline:
-            d['isBaseline'] = self.is_baseline
-        if self.name:
+        if self.formula is not None:
+            d['formula'] = self.formula
+        if self.is_input_parameter is not None:
+            d['isInputParameter'] = self.is_input_parameter
+        if self.last_change is not None:
+            d['lastChange'] = self.last_change
+        if self.library is not None:
+            d['library'] = self.library
+        if self.name is not None:
             d['name'] = self.name
-        if self.parameters:
-            d['parameters'] = [e.to_dict() for e in self.parameters]
+        if self.parameter_scope is not None:
+            d['parameterScope'] = self.parameter_scope.value
+        if self.tags is not None:
+            d['tags'] = self.tags
+        if self.uncertainty is not None:
+            d['uncertainty'] = self.uncertainty.to_dict()
+        if self.value is not None:
+            d['value'] = self.value
+        if self.version is not None:
+            d['version'] = self.version
         return d
 
+    def to_json(self) -> str:
+        return json.dumps(self.to_dict(), indent=2)
+
+    def to_ref(self) -> 'Ref[Parameter]':
+        ref: Ref[Parameter] = Ref(id=self.id, name=self.name)
+        ref.category = self.category
+        ref.model_type = 'Parameter'
+        return ref
+
+    @staticmethod
+    def from_dict(d: Dict[str, Any]) -> 'Parameter':
+        parameter = Parameter()
+        if (v := d.get('@id')) is not None:
+            parameter.id = v
+        if (v := d.get('category')) is not None:
+            parameter.category = v
+        if (v := d.get('description')) is not None:
+            parameter.description = v
+        if (v := d.get('formula')) is not None:
+            parameter.formula = v
+        if (v := d.get('isInputParameter')) is not None:
+            parameter.is_input_parameter = v
+        if (v := d.get('lastChange')) is not None:
+            parameter.last_change = v
+        if (v := d.get('library')) is not None:
+            parameter.library = v
+        if (v := d.get('name')) is not None:
+            parameter.name = v
+        if (v := d.get('parameterScope')) is not None:
+            parameter.parameter_scope = ParameterScope(v)
+        if (v := d.get('tags')) is not None:
+            parameter.tags = v
+        if (v := d.get('uncertainty')) is not None:
+            parameter.uncertainty = Uncertainty.from_dict(v)
+        if (v := d.get('value')) is not None:
+            parameter.value = v
+        if (v := d.get('version')) is not None:
+            parameter.version = v
+        return parameter
+
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'ParameterRedefSet':
-        parameter_redef_set = ParameterRedefSet()
-        if v := d.get('@type'):
-            parameter_redef_set.schema_type = v
-        if v := d.get('description'):
-            parameter_redef_set.description = v
-        if v := d.get('isBaseline'):
-            parameter_redef_set.is_baseline = v
-        if v := d.get('name'):
-            parameter_redef_set.name = v
-        if v := d.get('parameters'):
-            parameter_redef_set.parameters = [ParameterRedef.from_dict(e) for e in v]
-        return parameter_redef_set
+    def from_json(data: Union[str, bytes]) -> 'Parameter':
+        return Parameter.from_dict(json.loads(data))
+
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.id is None:
+            errors.append('id: value is required')
+        else:
+            _check_value(self.id, str, 'string', 'id', errors)
+        if self.category is not None:
+            _check_value(self.category, str, 'string', 'category', errors)
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.formula is not None:
+            _check_value(self.formula, str, 'string', 'formula', errors)
+        if self.is_input_parameter is not None:
+            _check_value(self.is_input_parameter, bool, 'boolean', 'is_input_parameter', errors)
+        if self.last_change is not None:
+            _check_value(self.last_change, str, 'dateTime', 'last_change', errors)
+        if self.library is not None:
+            _check_value(self.library, str, 'string', 'library', errors)
+        if self.name is None:
+            errors.append('name: value is required')
+        else:
+            _check_value(self.name, str, 'string', 'name', errors)
+        if self.parameter_scope is not None:
+            _check_value(self.parameter_scope, ParameterScope, 'ParameterScope', 'parameter_scope', errors)
+        if self.tags is not None:
+            if _check_value(self.tags, list, 'List[string]', 'tags', errors):
+                for i, e in enumerate(self.tags):
+                    _check_value(e, str, 'string', f'tags[{i}]', errors)
+        if self.uncertainty is not None:
+            _check_value(self.uncertainty, Uncertainty, 'Uncertainty', 'uncertainty', errors)
+        if self.value is not None:
+            _check_value(self.value, (int, float), 'double', 'value', errors)
+        if self.version is not None:
+            _check_value(self.version, str, 'string', 'version', errors)
+        return errors
 
 
 @dataclass
 class Process:
 
-    id: Optional[str] = None
+    id: Optional[str] = field(default_factory=lambda: str(uuid.uuid4()))
+    """The reference ID (or UUID) of this entity."""
     allocation_factors: Optional[List[AllocationFactor]] = None
     category: Optional[str] = None
+    """A full path of the category. Forward slashes are used to separate the
+    segments of this path, e.g. Elementary flows/emissions/air/unspecified.
+    """
     default_allocation_method: Optional[AllocationType] = None
     description: Optional[str] = None
+    """The description of the entity."""
     dq_entry: Optional[str] = None
-    dq_system: Optional[Ref] = None
-    exchange_dq_system: Optional[Ref] = None
+    """A data quality entry like (1;3;2;5;1). The entry is a vector of data
+    quality values that need to match the overall data quality system of the
+    process (the system that is stored in the dqSystem property). In such a
+    system the data quality indicators have fixed positions and the
+    respective values in the dqEntry vector map to these positions.
+    """
+    dq_system: Optional[Ref[DQSystem]] = None
+    """A reference to a data quality system ([DQSystem]) with which the overall
+    quality of the process can be assessed.
+    """
+    exchange_dq_system: Optional[Ref[DQSystem]] = None
+    """A reference to a data quality system ([DQSystem]) with which the quality
+    of individual inputs and outputs ([Exchange]s) of the process can be
+    assessed.
+    """
     exchanges: Optional[List[Exchange]] = None
+    """The inputs and outputs of the process."""
     is_infrastructure_process: Optional[bool] = None
+    """Indicates whether this process describes an infrastructure process. This
+    field is part of the openLCA schema because of backward compatibility
+    with EcoSpold 1. It does not really have a meaning in openLCA and should
+    not be used anymore.
+    """
     last_change: Optional[str] = None
+    """The timestamp when the entity was changed the last time."""
     last_internal_id: Optional[int] = None
+    """This field holds the last internal ID that was used in an exchange
+    (which may have been deleted, so it can be larger than the largest
+    internal ID of the exchanges of the process.) The internal ID of an
+    exchange is used to identify exchanges within a process (for updates,
+    data exchanges (see process links), etc.). When you add an exchange to a
+    process, you should increment this field in the process and set the
+    resulting value as the internal ID of that exchange. The sequence of
+    internal IDs should start with 1.
+    """
     library: Optional[str] = None
-    location: Optional[Ref] = None
+    """If this entity is part of a library, this field contains the identifier
+    of that library. The identifier is typically just the combination of the
+    library name and version.
+    """
+    location: Optional[Ref[Location]] = None
+    """The location of the process."""
     name: Optional[str] = None
+    """The name of the entity."""
     parameters: Optional[List[Parameter]] = None
     process_documentation: Optional[ProcessDocumentation] = None
     process_type: Optional[ProcessType] = None
     social_aspects: Optional[List[SocialAspect]] = None
-    social_dq_system: Optional[Ref] = None
+    """A set of social aspects related to this process."""
+    social_dq_system: Optional[Ref[DQSystem]] = None
+    """A reference to a data quality system ([DQSystem]) with which the quality
+    of individual social aspects of the process can be assessed.
+    """
     tags: Optional[List[str]] = None
-    version: Optional[str] = None
-
-    def __post_init__(self):
-        if self.id is None:
-            self.id = str(uuid.uuid4())
+    """A list of optional tags. A tag is just a string which should not contain
+    commas (and other special characters).
+    """
+    version: Optional[str] = '01.00.000'
+    """A version number in MAJOR.MINOR.PATCH format where the MINOR and PATCH
+    fields are optional and the fields may have leading zeros (so 01.00.00
+    is the same as 1.0.0 or 1).
+    """
+
+    def __post_init__(self) -> None:
         if self.version is None:
             self.version = '01.00.000'
         if self.last_change is None:
@@ -2775,57 +4127,57 @@ class Process:
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
         d['@type'] = 'Process'
-        if self.id:
+        if self.id is not None:
             d['@id'] = self.id
-        if self.allocation_factors:
+        if self.allocation_factors is not None:
             d['allocationFactors'] = [e.to_dict() for e in self.allocation_factors]
-        if self.category:
+        if self.category is not None:
             d['category'] = self.category
-        if self.default_allocation_method:
+        if self.default_allocation_method is not None:
             d['defaultAllocationMethod'] = self.default_allocation_method.value
-        if self.description:
+        if self.description is not None:
             d['description'] = self.description
-        if self.dq_entry:
+        if self.dq_entry is not None:
             d['dqEntry'] = self.dq_entry
-        if self.dq_system:
+        if self.dq_system is not None:
             d['dqSystem'] = self.dq_system.to_dict()
-        if self.exchange_dq_system:
+        if self.exchange_dq_system is not None:
             d['exchangeDqSystem'] = self.exchange_dq_system.to_dict()
-        if self.exchanges:
+        if self.exchanges is not None:
             d['exchanges'] = [e.to_dict() for e in self.exchanges]
-        if self.is_infrastructure_process:
+        if self.is_infrastructure_process is not None:
             d['isInfrastructureProcess'] = self.is_infrastructure_process
-        if self.last_change:
+        if self.last_change is not None:
             d['lastChange'] = self.last_change
-        if self.last_internal_id:
+        if self.last_internal_id is not None:
             d['lastInternalId'] = self.last_internal_id
-        if self.library:
+        if self.library is not None:
             d['library'] = self.library
-        if self.location:
+        if self.location is not None:
             d['location'] = self.location.to_dict()
-        if self.name:
+        if self.name is not None:
             d['name'] = self.name
-        if self.parameters:
+        if self.parameters is not None:
             d['parameters'] = [e.to_dict() for e in self.parameters]
-        if self.process_documentation:
+        if self.process_documentation is not None:
             d['processDocumentation'] = self.process_documentation.to_dict()
-        if self.process_type:
+        if self.process_type is not None:
             d['processType'] = self.process_type.value
-        if self.social_aspects:
+        if self.social_aspects is not None:
             d['socialAspects'] = [e.to_dict() for e in self.social_aspects]
-        if self.social_dq_system:
+        if self.social_dq_system is not None:
             d['socialDqSystem'] = self.social_dq_system.to_dict()
-        if self.tags:
+        if self.tags is not None:
             d['tags'] = self.tags
-        if self.version:
+        if self.version is not None:
             d['version'] = self.version
         return d
 
     def to_json(self) -> str:
         return json.dumps(self.to_dict(), indent=2)
 
-    def to_ref(self) -> 'Ref':
-        ref = Ref(id=self.id, name=self.name)
+    def to_ref(self) -> 'Ref[Process]':
+        ref: Ref[Process] = Ref(id=self.id, name=self.name)
         ref.category = self.category
         ref.model_type = 'Process'
         return ref
@@ -2833,51 +4185,49 @@ class Process:
     @staticmethod
     def from_dict(d: Dict[str, Any]) -> 'Process':
         process = Process()
-        if v := d.get('@type'):
-            process.schema_type = v
-        if v := d.get('@id'):
+        if (v := d.get('@id')) is not None:
             process.id = v
-        if v := d.get('allocationFactors'):
+        if (v := d.get('allocationFactors')) is not None:
             process.allocation_factors = [AllocationFactor.from_dict(e) for e in v]
-        if v := d.get('category'):
+        if (v := d.get('category')) is not None:
             process.category = v
-        if v := d.get('defaultAllocationMethod'):
-            process.default_allocation_method = v
-        if v := d.get('description'):
+        if (v := d.get('defaultAllocationMethod')) is not None:
+            process.default_allocation_method = AllocationType(v)
+        if (v := d.get('description')) is not None:
             process.description = v
-        if v := d.get('dqEntry'):
+        if (v := d.get('dqEntry')) is not None:
             process.dq_entry = v
-        if v := d.get('dqSystem'):
-            process.dq_system = Ref[DQSystem].from_dict(v)
-        if v := d.get('exchangeDqSystem'):
-            process.exchange_dq_system = Ref[DQSystem].from_dict(v)
-        if v := d.get('exchanges'):
+        if (v := d.get('dqSystem')) is not None:
+            process.dq_system = Ref.from_dict(v, 'DQSystem')
+        if (v := d.get('exchangeDqSystem')) is not None:
+            process.exchange_dq_system = Ref.from_dict(v, 'DQSystem')
+        if (v := d.get('exchanges')) is not None:
             process.exchanges = [Exchange.from_dict(e) for e in v]
-        if v := d.get('isInfrastructureProcess'):
+        if (v := d.get('isInfrastructureProcess')) is not None:
             process.is_infrastructure_process = v
-        if v := d.get('lastChange'):
+        if (v := d.get('lastChange')) is not None:
             process.last_change = v
-        if v := d.get('lastInternalId'):
+        if (v := d.get('lastInternalId')) is not None:
             process.last_internal_id = v
-        if v := d.get('library'):
+        if (v := d.get('library')) is not None:
             process.library = v
-        if v := d.get('location'):
-            process.location = Ref[Location].from_dict(v)
-        if v := d.get('name'):
+        if (v := d.get('location')) is not None:
+            process.location = Ref.from_dict(v, 'Location')
+        if (v := d.get('name')) is not None:
             process.name = v
-        if v := d.get('parameters'):
+        if (v := d.get('parameters')) is not None:
             process.parameters = [Parameter.from_dict(e) for e in v]
-        if v := d.get('processDocumentation'):
+        if (v := d.get('processDocumentation')) is not None:
             process.process_documentation = ProcessDocumentation.from_dict(v)
-        if v := d.get('processType'):
-            process.process_type = v
-        if v := d.get('socialAspects'):
+        if (v := d.get('processType')) is not None:
+            process.process_type = ProcessType(v)
+        if (v := d.get('socialAspects')) is not None:
             process.social_aspects = [SocialAspect.from_dict(e) for e in v]
-        if v := d.get('socialDqSystem'):
-            process.social_dq_system = Ref[DQSystem].from_dict(v)
-        if v := d.get('tags'):
+        if (v := d.get('socialDqSystem')) is not None:
+            process.social_dq_system = Ref.from_dict(v, 'DQSystem')
+        if (v := d.get('tags')) is not None:
             process.tags = v
-        if v := d.get('version'):
+        if (v := d.get('version')) is not None:
             process.version = v
         return process
 
@@ -2885,30 +4235,129 @@ class Process:
     def from_json(data: Union[str, bytes]) -> 'Process':
         return Process.from_dict(json.loads(data))
 
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.id is None:
+            errors.append('id: value is required')
+        else:
+            _check_value(self.id, str, 'string', 'id', errors)
+        if self.allocation_factors is not None:
+            if _check_value(self.allocation_factors, list, 'List[AllocationFactor]', 'allocation_factors', errors):
+                for i, e in enumerate(self.allocation_factors):
+                    _check_value(e, AllocationFactor, 'AllocationFactor', f'allocation_factors[{i}]', errors)
+        if self.category is not None:
+            _check_value(self.category, str, 'string', 'category', errors)
+        if self.default_allocation_method is not None:
+            _check_value(self.default_allocation_method, AllocationType, 'AllocationType', 'default_allocation_method', errors)
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.dq_entry is not None:
+            _check_value(self.dq_entry, str, 'string', 'dq_entry', errors)
+        if self.dq_system is not None:
+            _check_value(self.dq_system, Ref, 'Ref[DQSystem]', 'dq_system', errors)
+        if self.exchange_dq_system is not None:
+            _check_value(self.exchange_dq_system, Ref, 'Ref[DQSystem]', 'exchange_dq_system', errors)
+        if self.exchanges is not None:
+            if _check_value(self.exchanges, list, 'List[Exchange]', 'exchanges', errors):
+                for i, e in enumerate(self.exchanges):
+                    _check_value(e, Exchange, 'Exchange', f'exchanges[{i}]', errors)
+        if self.is_infrastructure_process is not None:
+            _check_value(self.is_infrastructure_process, bool, 'boolean', 'is_infrastructure_process', errors)
+        if self.last_change is not None:
+            _check_value(self.last_change, str, 'dateTime', 'last_change', errors)
+        if self.last_internal_id is not None:
+            _check_value(self.last_internal_id, int, 'int', 'last_internal_id', errors)
+        if self.library is not None:
+            _check_value(self.library, str, 'string', 'library', errors)
+        if self.location is not None:
+            _check_value(self.location, Ref, 'Ref[Location]', 'location', errors)
+        if self.name is None:
+            errors.append('name: value is required')
+        else:
+            _check_value(self.name, str, 'string', 'name', errors)
+        if self.parameters is not None:
+            if _check_value(self.parameters, list, 'List[Parameter]', 'parameters', errors):
+                for i, e in enumerate(self.parameters):
+                    _check_value(e, Parameter, 'Parameter', f'parameters[{i}]', errors)
+        if self.process_documentation is not None:
+            _check_value(self.process_documentation, ProcessDocumentation, 'ProcessDocumentation', 'process_documentation', errors)
+        if self.process_type is not None:
+            _check_value(self.process_type, ProcessType, 'ProcessType', 'process_type', errors)
+        if self.social_aspects is not None:
+            if _check_value(self.social_aspects, list, 'List[SocialAspect]', 'social_aspects', errors):
+                for i, e in enumerate(self.social_aspects):
+                    _check_value(e, SocialAspect, 'SocialAspect', f'social_aspects[{i}]', errors)
+        if self.social_dq_system is not None:
+            _check_value(self.social_dq_system, Ref, 'Ref[DQSystem]', 'social_dq_system', errors)
+        if self.tags is not None:
+            if _check_value(self.tags, list, 'List[string]', 'tags', errors):
+                for i, e in enumerate(self.tags):
+                    _check_value(e, str, 'string', f'tags[{i}]', errors)
+        if self.version is not None:
+            _check_value(self.version, str, 'string', 'version', errors)
+        return errors
+
 
 @dataclass
 class ProductSystem:
+    """A product system describes the supply chain of a product (the functional
+    unit) ...
+    """
 
-    id: Optional[str] = None
+    id: Optional[str] = field(default_factory=lambda: str(uuid.uuid4()))
+    """The reference ID (or UUID) of this entity."""
     category: Optional[str] = None
+    """A full path of the category. Forward slashes are used to separate the
+    segments of this path, e.g. Elementary flows/emissions/air/unspecified.
+    """
     description: Optional[str] = None
+    """The description of the entity."""
     last_change: Optional[str] = None
+    """The timestamp when the entity was changed the last time."""
     library: Optional[str] = None
+    """If this entity is part of a library, this field contains the identifier
+    of that library. The identifier is typically just the combination of the
+    library name and version.
+    """
     name: Optional[str] = None
+    """The name of the entity."""
     parameter_sets: Optional[List[ParameterRedefSet]] = None
+    """A list of possible sets of parameter redefinitions for this product
+    system.
+    """
     process_links: Optional[List[ProcessLink]] = None
+    """The process links of the product system."""
     processes: Optional[List[Ref]] = None
+    """The descriptors of all processes and sub-systems that are contained in
+    the product system.
+    """
     ref_exchange: Optional[ExchangeRef] = None
-    ref_process: Optional[Ref] = None
+    """The exchange of the reference processes (typically the product output)
+    that provides the flow of the functional unit of the product system.
+    """
+    ref_process: Optional[Ref[Process]] = None
+    """The descriptor of the process that provides the flow of the functional
+    unit of the product system.
+    """
     tags: Optional[List[str]] = None
+    """A list of optional tags. A tag is just a string which should not contain
+    commas (and other special characters).
+    """
     target_amount: Optional[float] = None
-    target_flow_property: Optional[Ref] = None
-    target_unit: Optional[Ref] = None
-    version: Optional[str] = None
-
-    def __post_init__(self):
-        if self.id is None:
-            self.id = str(uuid.uuid4())
+    """The flow amount of the functional unit of the product system."""
+    target_flow_property: Optional[Ref[FlowProperty]] = None
+    """The flow property in which the flow amount of the functional unit is
+    given.
+    """
+    target_unit: Optional[Ref[Unit]] = None
+    """The unit in which the flow amount of the functional unit is given."""
+    version: Optional[str] = '01.00.000'
+    """A version number in MAJOR.MINOR.PATCH format where the MINOR and PATCH
+    fields are optional and the fields may have leading zeros (so 01.00.00
+    is the same as 1.0.0 or 1).
+    """
+
+    def __post_init__(self) -> None:
         if self.version is None:
             self.version = '01.00.000'
         if self.last_change is None:
@@ -2917,45 +4366,45 @@ class ProductSystem:
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
         d['@type'] = 'ProductSystem'
-        if self.id:
+        if self.id is not None:
             d['@id'] = self.id
-        if self.category:
+        if self.category is not None:
             d['category'] = self.category
-        if self.description:
+        if self.description is not None:
             d['description'] = self.description
-        if self.last_change:
+        if self.last_change is not None:
             d['lastChange'] = self.last_change
-        if self.library:
+        if self.library is not None:
             d['library'] = self.library
-        if self.name:
+        if self.name is not None:
             d['name'] = self.name
-        if self.parameter_sets:
+        if self.parameter_sets is not None:
             d['parameterSets'] = [e.to_dict() for e in self.parameter_sets]
-        if self.process_links:
+        if self.process_links is not None:
             d['processLinks'] = [e.to_dict() for e in self.process_links]
-        if self.processes:
+        if self.processes is not None:
             d['processes'] = [e.to_dict() for e in self.processes]
-        if self.ref_exchange:
+        if self.ref_exchange is not None:
             d['refExchange'] = self.ref_exchange.to_dict()
-        if self.ref_process:
+        if self.ref_process is not None:
             d['refProcess'] = self.ref_process.to_dict()
-        if self.tags:
+        if self.tags is not None:
             d['tags'] = self.tags
-        if self.target_amount:
+        if self.target_amount is not None:
             d['targetAmount'] = self.target_amount
-        if self.target_flow_property:
+        if self.target_flow_property is not None:
             d['targetFlowProperty'] = self.target_flow_property.to_dict()
-        if self.target_unit:
+        if self.target_unit is not None:
             d['targetUnit'] = self.target_unit.to_dict()
-        if self.version:
+        if self.version is not None:
             d['version'] = self.version
         return d
 
     def to_json(self) -> str:
         return json.dumps(self.to_dict(), indent=2)
 
-    def to_ref(self) -> 'Ref':
-        ref = Ref(id=self.id, name=self.name)
+    def to_ref(self) -> 'Ref[ProductSystem]':
+        ref: Ref[ProductSystem] = Ref(id=self.id, name=self.name)
         ref.category = self.category
         ref.model_type = 'ProductSystem'
         return ref
@@ -2963,39 +4412,37 @@ class ProductSystem:
     @staticmethod
     def from_dict(d: Dict[str, Any]) -> 'ProductSystem':
         product_system = ProductSystem()
-        if v := d.get('@type'):
-            product_system.schema_type = v
-        if v := d.get('@id'):
+        if (v := d.get('@id')) is not None:
             product_system.id = v
-        if v := d.get('category'):
+        if (v := d.get('category')) is not None:
             product_system.category = v
-        if v := d.get('description'):
+        if (v := d.get('description')) is not None:
             product_system.description = v
-        if v := d.get('lastChange'):
+        if (v := d.get('lastChange')) is not None:
             product_system.last_change = v
-        if v := d.get('library'):
+        if (v := d.get('library')) is not None:
             product_system.library = v
-        if v := d.get('name'):
+        if (v := d.get('name')) is not None:
             product_system.name = v
-        if v := d.get('parameterSets'):
+        if (v := d.get('parameterSets')) is not None:
             product_system.parameter_sets = [ParameterRedefSet.from_dict(e) for e in v]
-        if v := d.get('processLinks'):
+        if (v := d.get('processLinks')) is not None:
             product_system.process_links = [ProcessLink.from_dict(e) for e in v]
-        if v := d.get('processes'):
+        if (v := d.get('processes')) is not None:
             product_system.processes = [Ref.from_dict(e) for e in v]
-        if v := d.get('refExchange'):
+        if (v := d.get('refExchange')) is not None:
             product_system.ref_exchange = ExchangeRef.from_dict(v)
-        if v := d.get('refProcess'):
-            product_system.ref_process = Ref[Process].from_dict(v)
-        if v := d.get('tags'):
+        if (v := d.get('refProcess')) is not None:
+            product_system.ref_process = Ref.from_dict(v, 'Process')
+        if (v := d.get('tags')) is not None:
             product_system.tags = v
-        if v := d.get('targetAmount'):
+        if (v := d.get('targetAmount')) is not None:
             product_system.target_amount = v
-        if v := d.get('targetFlowProperty'):
-            product_system.target_flow_property = Ref[FlowProperty].from_dict(v)
-        if v := d.get('targetUnit'):
-            product_system.target_unit = Ref[Unit].from_dict(v)
-        if v := d.get('version'):
+        if (v := d.get('targetFlowProperty')) is not None:
+            product_system.target_flow_property = Ref.from_dict(v, 'FlowProperty')
+        if (v := d.get('targetUnit')) is not None:
+            product_system.target_unit = Ref.from_dict(v, 'Unit')
+        if (v := d.get('version')) is not None:
             product_system.version = v
         return product_system
 
@@ -3003,83 +4450,91 @@ class ProductSystem:
     def from_json(data: Union[str, bytes]) -> 'ProductSystem':
         return ProductSystem.from_dict(json.loads(data))
 
-
-@dataclass
-class ProjectVariant:
-
-    allocation_method: Optional[AllocationType] = None
-    amount: Optional[float] = None
-    description: Optional[str] = None
-    is_disabled: Optional[bool] = None
-    name: Optional[str] = None
-    parameter_redefs: Optional[List[ParameterRedef]] = None
-    product_system: Optional[Ref] = None
-    unit: Optional[Ref] = None
-
-    def to_dict(self) -> Dict[str, Any]:
-        d: Dict[str, Any] = {}
-        if self.allocation_method:
-            d['allocationMethod'] = self.allocation_method.value
-        if self.amount:
-            d['amount'] = self.amount
-        if self.description:
-            d['description'] = self.description
-        if self.is_disabled:
-            d['isDisabled'] = self.is_disabled
-        if self.name:
-            d['name'] = self.name
-        if self.parameter_redefs:
-            d['parameterRedefs'] = [e.to_dict() for e in self.parameter_redefs]
-        if self.product_system:
-            d['productSystem'] = self.product_system.to_dict()
-        if self.unit:
-            d['unit'] = self.unit.to_dict()
-        return d
-
-    @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'ProjectVariant':
-        project_variant = ProjectVariant()
-        if v := d.get('@type'):
-            project_variant.schema_type = v
-        if v := d.get('allocationMethod'):
-            project_variant.allocation_method = v
-        if v := d.get('amount'):
-            project_variant.amount = v
-        if v := d.get('description'):
-            project_variant.description = v
-        if v := d.get('isDisabled'):
-            project_variant.is_disabled = v
-        if v := d.get('name'):
-            project_variant.name = v
-        if v := d.get('parameterRedefs'):
-            project_variant.parameter_redefs = [ParameterRedef.from_dict(e) for e in v]
-        if v := d.get('productSystem'):
-            project_variant.product_system = Ref[ProductSystem].from_dict(v)
-        if v := d.get('unit'):
-            project_variant.unit = Ref[Unit].from_dict(v)
-        return project_variant
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.id is None:
+            errors.append('id: value is required')
+        else:
+            _check_value(self.id, str, 'string', 'id', errors)
+        if self.category is not None:
+            _check_value(self.category, str, 'string', 'category', errors)
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.last_change is not None:
+            _check_value(self.last_change, str, 'dateTime', 'last_change', errors)
+        if self.library is not None:
+            _check_value(self.library, str, 'string', 'library', errors)
+        if self.name is None:
+            errors.append('name: value is required')
+        else:
+            _check_value(self.name, str, 'string', 'name', errors)
+        if self.parameter_sets is not None:
+            if _check_value(self.parameter_sets, list, 'List[ParameterRedefSet]', 'parameter_sets', errors):
+                for i, e in enumerate(self.parameter_sets):
+                    _check_value(e, ParameterRedefSet, 'ParameterRedefSet', f'parameter_sets[{i}]', errors)
+        if self.process_links is not None:
+            if _check_value(self.process_links, list, 'List[ProcessLink]', 'process_links', errors):
+                for i, e in enumerate(self.process_links):
+                    _check_value(e, ProcessLink, 'ProcessLink', f'process_links[{i}]', errors)
+        if self.processes is not None:
+            if _check_value(self.processes, list, 'List[Ref]', 'processes', errors):
+                for i, e in enumerate(self.processes):
+                    _check_value(e, Ref, 'Ref', f'processes[{i}]', errors)
+        if self.ref_exchange is not None:
+            _check_value(self.ref_exchange, ExchangeRef, 'ExchangeRef', 'ref_exchange', errors)
+        if self.ref_process is not None:
+            _check_value(self.ref_process, Ref, 'Ref[Process]', 'ref_process', errors)
+        if self.tags is not None:
+            if _check_value(self.tags, list, 'List[string]', 'tags', errors):
+                for i, e in enumerate(self.tags):
+                    _check_value(e, str, 'string', f'tags[{i}]', errors)
+        if self.target_amount is not None:
+            _check_value(self.target_amount, (int, float), 'double', 'target_amount', errors)
+        if self.target_flow_property is not None:
+            _check_value(self.target_flow_property, Ref, 'Ref[FlowProperty]', 'target_flow_property', errors)
+        if self.target_unit is not None:
+            _check_value(self.target_unit, Ref, 'Ref[Unit]', 'target_unit', errors)
+        if self.version is not None:
+            _check_value(self.version, str, 'string', 'version', errors)
+        return errors
 
 
 @dataclass
 class Project:
 
-    id: Optional[str] = None
+    id: Optional[str] = field(default_factory=lambda: str(uuid.uuid4()))
+    """The reference ID (or UUID) of this entity."""
     category: Optional[str] = None
+    """A full path of the category. Forward slashes are used to separate the
+    segments of this path, e.g. Elementary flows/emissions/air/unspecified.
+    """
     description: Optional[str] = None
-    impact_method: Optional[Ref] = None
+    """The description of the entity."""
+    impact_method: Optional[Ref[ImpactMethod]] = None
     is_with_costs: Optional[bool] = None
     is_with_regionalization: Optional[bool] = None
     last_change: Optional[str] = None
+    """The timestamp when the entity was changed the last time."""
     library: Optional[str] = None
+    """If this entity is part of a library, this field contains the identifier
+    of that library. The identifier is typically just the combination of the
+    library name and version.
+    """
     name: Optional[str] = None
+    """The name of the entity."""
     nw_set: Optional[NwSet] = None
     tags: Optional[List[str]] = None
+    """A list of optional tags. A tag is just a string which should not contain
+    commas (and other special characters).
+    """
     variants: Optional[List[ProjectVariant]] = None
-    version: Optional[str] = None
+    version: Optional[str] = '01.00.000'
+    """A version number in MAJOR.MINOR.PATCH format where the MINOR and PATCH
+    fields are optional and the fields may have leading zeros (so 01.00.00
+    is the same as 1.0.0 or 1).
+    """
 
-    def __post_init__(self):
-        if self.id is None:
-            self.id = str(uuid.uuid4())
+    def __post_init__(self) -> None:
         if self.version is None:
             self.version = '01.00.000'
         if self.last_change is None:
@@ -3088,39 +4543,39 @@ class Project:
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
         d['@type'] = 'Project'
-        if self.id:
+        if self.id is not None:
             d['@id'] = self.id
-        if self.category:
+        if self.category is not None:
             d['category'] = self.category
-        if self.description:
+        if self.description is not None:
             d['description'] = self.description
-        if self.impact_method:
+        if self.impact_method is not None:
             d['impactMethod'] = self.impact_method.to_dict()
-        if self.is_with_costs:
+        if self.is_with_costs is not None:
             d['isWithCosts'] = self.is_with_costs
-        if self.is_with_regionalization:
+        if self.is_with_regionalization is not None:
             d['isWithRegionalization'] = self.is_with_regionalization
-        if self.last_change:
+        if self.last_change is not None:
             d['lastChange'] = self.last_change
-        if self.library:
+        if self.library is not None:
             d['library'] = self.library
-        if self.name:
+        if self.name is not None:
             d['name'] = self.name
-        if self.nw_set:
+        if self.nw_set is not None:
             d['nwSet'] = self.nw_set.to_dict()
-        if self.tags:
+        if self.tags is not None:
             d['tags'] = self.tags
-        if self.variants:
+        if self.variants is not None:
             d['variants'] = [e.to_dict() for e in self.variants]
-        if self.version:
+        if self.version is not None:
             d['version'] = self.version
         return d
 
     def to_json(self) -> str:
         return json.dumps(self.to_dict(), indent=2)
 
-    def to_ref(self) -> 'Ref':
-        ref = Ref(id=self.id, name=self.name)
+    def to_ref(self) -> 'Ref[Project]':
+        ref: Ref[Project] = Ref(id=self.id, name=self.name)
         ref.category = self.category
         ref.model_type = 'Project'
         return ref
@@ -3128,33 +4583,31 @@ class Project:
     @staticmethod
     def from_dict(d: Dict[str, Any]) -> 'Project':
         project = Project()
-        if v := d.get('@type'):
-            project.schema_type = v
-        if v := d.get('@id'):
+        if (v := d.get('@id')) is not None:
             project.id = v
-        if v := d.get('category'):
+        if (v := d.get('category')) is not None:
             project.category = v
-        if v := d.get('description'):
+        if (v := d.get('description')) is not None:
             project.description = v
-        if v := d.get('impactMethod'):
-            project.impact_method = Ref[ImpactMethod].from_dict(v)
-        if v := d.get('isWithCosts'):
+        if (v := d.get('impactMethod')) is not None:
+            project.impact_method = Ref.from_dict(v, 'ImpactMethod')
+        if (v := d.get('isWithCosts')) is not None:
             project.is_with_costs = v
-        if v := d.get('isWithRegionalization'):
+        if (v := d.get('isWithRegionalization')) is not None:
             project.is_with_regionalization = v
-        if v := d.get('lastChange'):
+        if (v := d.get('lastChange')) is not None:
             project.last_change = v
-        if v := d.get('library'):
+        if (v := d.get('library')) is not None:
             project.library = v
-        if v := d.get('name'):
+        if (v := d.get('name')) is not None:
             project.name = v
-        if v := d.get('nwSet'):
+        if (v := d.get('nwSet')) is not None:
             project.nw_set = NwSet.from_dict(v)
-        if v := d.get('tags'):
+        if (v := d.get('tags')) is not None:
             project.tags = v
-        if v := d.get('variants'):
+        if (v := d.get('variants')) is not None:
             project.variants = [ProjectVariant.from_dict(e) for e in v]
-        if v := d.get('version'):
+        if (v := d.get('version')) is not None:
             project.version = v
         return project
 
@@ -3162,76 +4615,550 @@ class Project:
     def from_json(data: Union[str, bytes]) -> 'Project':
         return Project.from_dict(json.loads(data))
 
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.id is None:
+            errors.append('id: value is required')
+        else:
+            _check_value(self.id, str, 'string', 'id', errors)
+        if self.category is not None:
+            _check_value(self.category, str, 'string', 'category', errors)
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.impact_method is not None:
+            _check_value(self.impact_method, Ref, 'Ref[ImpactMethod]', 'impact_method', errors)
+        if self.is_with_costs is not None:
+            _check_value(self.is_with_costs, bool, 'boolean', 'is_with_costs', errors)
+        if self.is_with_regionalization is not None:
+            _check_value(self.is_with_regionalization, bool, 'boolean', 'is_with_regionalization', errors)
+        if self.last_change is not None:
+            _check_value(self.last_change, str, 'dateTime', 'last_change', errors)
+        if self.library is not None:
+            _check_value(self.library, str, 'string', 'library', errors)
+        if self.name is None:
+            errors.append('name: value is required')
+        else:
+            _check_value(self.name, str, 'string', 'name', errors)
+        if self.nw_set is not None:
+            _check_value(self.nw_set, NwSet, 'NwSet', 'nw_set', errors)
+        if self.tags is not None:
+            if _check_value(self.tags, list, 'List[string]', 'tags', errors):
+                for i, e in enumerate(self.tags):
+                    _check_value(e, str, 'string', f'tags[{i}]', errors)
+        if self.variants is not None:
+            if _check_value(self.variants, list, 'List[ProjectVariant]', 'variants', errors):
+                for i, e in enumerate(self.variants):
+                    _check_value(e, ProjectVariant, 'ProjectVariant', f'variants[{i}]', errors)
+        if self.version is not None:
+            _check_value(self.version, str, 'string', 'version', errors)
+        return errors
+
 
 @dataclass
-class Unit:
+class Result:
+    """A calculation result of a product system."""
 
-    id: Optional[str] = None
-    conversion_factor: Optional[float] = None
+    id: Optional[str] = field(default_factory=lambda: str(uuid.uuid4()))
+    """The reference ID (or UUID) of this entity."""
+    category: Optional[str] = None
+    """A full path of the category. Forward slashes are used to separate the
+    segments of this path, e.g. Elementary flows/emissions/air/unspecified.
+    """
     description: Optional[str] = None
-    is_ref_unit: Optional[bool] = None
+    """The description of the entity."""
+    flow_results: Optional[List[FlowResult]] = None
+    """An array of FlowResult objects."""
+    impact_method: Optional[Ref[ImpactMethod]] = None
+    """A reference to the ImpactMethod that was used to calculate the result.
+    """
+    impact_results: Optional[List[ImpactResult]] = None
+    """An array of ImpactResult objects.1"""
+    last_change: Optional[str] = None
+    """The timestamp when the entity was changed the last time."""
+    library: Optional[str] = None
+    """If this entity is part of a library, this field contains the identifier
+    of that library. The identifier is typically just the combination of the
+    library name and version.
+    """
     name: Optional[str] = None
-    synonyms: Optional[List[str]] = None
+    """The name of the entity."""
+    product_system: Optional[Ref[ProductSystem]] = None
+    """A reference to the ProductSystem from which this result was calculated.
+    """
+    tags: Optional[List[str]] = None
+    """A list of optional tags. A tag is just a string which should not contain
+    commas (and other special characters).
+    """
+    version: Optional[str] = '01.00.000'
+    """A version number in MAJOR.MINOR.PATCH format where the MINOR and PATCH
+    fields are optional and the fields may have leading zeros (so 01.00.00
+    is the same as 1.0.0 or 1).
+    """
+
+    def __post_init__(self) -> None:
+        if self.version is None:
+            self.version = '01.00.000'
+        if self.last_change is None:
+            self.last_change = datetime.datetime.utcnow().isoformat() + 'Z'
 
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
-        if self.id:
+        d['@type'] = 'Result'
+        if self.id is not None:
             d['@id'] = self.id
-        if self.conversion_factor:
-            d['conversionFactor'] = self.conversion_factor
-        if self.description:
+        if self.category is not None:
+            d['category'] = self.category
+        if self.description is not None:
             d['description'] = self.description
-        if self.is_ref_unit:
-            d['isRefUnit'] = self.is_ref_unit
-        if self.name:
+        if self.flow_results is not None:
+            d['flowResults'] = [e.to_dict() for e in self.flow_results]
+        if self.impact_method is not None:
+            d['impactMethod'] = self.impact_method.to_dict()
+        if self.impact_results is not None:
+            d['impactResults'] = [e.to_dict() for e in self.impact_results]
+        if self.last_change is not None:
+            d['lastChange'] = self.last_change
+        if self.library is not None:
+            d['library'] = self.library
+        if self.name is not None:
             d['name'] = self.name
-        if self.synonyms:
-            d['synonyms'] = self.synonyms
+        if self.product_system is not None:
+            d['productSystem'] = self.product_system.to_dict()
+        if self.tags is not None:
+            d['tags'] = self.tags
+        if self.version is not None:
+            d['version'] = self.version
         return d
 
-    def to_ref(self) -> 'Ref':
-        ref = Ref(id=self.id, name=self.name)
+    def to_json(self) -> str:
+        return json.dumps(self.to_dict(), indent=2)
+
+    def to_ref(self) -> 'Ref[Result]':
+        ref: Ref[Result] = Ref(id=self.id, name=self.name)
         ref.category = self.category
-        ref.model_type = 'Unit'
+        ref.model_type = 'Result'
         return ref
 
     @staticmethod
-    def from_dict(d: Dict[str, Any]) -> 'Unit':
-        unit = Unit()
-        if v := d.get('@type'):
-            unit.schema_type = v
-        if v := d.get('@id'):
-            unit.id = v
-        if v := d.get('conversionFactor'):
-            unit.conversion_factor = v
-        if v := d.get('description'):
-            unit.description = v
-        if v := d.get('isRefUnit'):
-            unit.is_ref_unit = v
-        if v := d.get('name'):
-            unit.name = v
-        if v := d.get('synonyms'):
-            unit.synonyms = v
-        return unit
+    def from_dict(d: Dict[str, Any]) -> 'Result':
+        result = Result()
+        if (v := d.get('@id')) is not None:
+            result.id = v
+        if (v := d.get('category')) is not None:
+            result.category = v
+        if (v := d.get('description')) is not None:
+            result.description = v
+        if (v := d.get('flowResults')) is not None:
+            result.flow_results = [FlowResult.from_dict(e) for e in v]
+        if (v := d.get('impactMethod')) is not None:
+            result.impact_method = Ref.from_dict(v, 'ImpactMethod')
+        if (v := d.get('impactResults')) is not None:
+            result.impact_results = [ImpactResult.from_dict(e) for e in v]
+        if (v := d.get('lastChange')) is not None:
+            result.last_change = v
+        if (v := d.get('library')) is not None:
+            result.library = v
+        if (v := d.get('name')) is not None:
+            result.name = v
+        if (v := d.get('productSystem')) is not None:
+            result.product_system = Ref.from_dict(v, 'ProductSystem')
+        if (v := d.get('tags')) is not None:
+            result.tags = v
+        if (v := d.get('version')) is not None:
+            result.version = v
+        return result
+
+    @staticmethod
+    def from_json(data: Union[str, bytes]) -> 'Result':
+        return Result.from_dict(json.loads(data))
+
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.id is None:
+            errors.append('id: value is required')
+        else:
+            _check_value(self.id, str, 'string', 'id', errors)
+        if self.category is not None:
+            _check_value(self.category, str, 'string', 'category', errors)
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.flow_results is not None:
+            if _check_value(self.flow_results, list, 'List[FlowResult]', 'flow_results', errors):
+                for i, e in enumerate(self.flow_results):
+                    _check_value(e, FlowResult, 'FlowResult', f'flow_results[{i}]', errors)
+        if self.impact_method is not None:
+            _check_value(self.impact_method, Ref, 'Ref[ImpactMethod]', 'impact_method', errors)
+        if self.impact_results is not None:
+            if _check_value(self.impact_results, list, 'List[ImpactResult]', 'impact_results', errors):
+                for i, e in enumerate(self.impact_results):
+                    _check_value(e, ImpactResult, 'ImpactResult', f'impact_results[{i}]', errors)
+        if self.last_change is not None:
+            _check_value(self.last_change, str, 'dateTime', 'last_change', errors)
+        if self.library is not None:
+            _check_value(self.library, str, 'string', 'library', errors)
+        if self.name is None:
+            errors.append('name: value is required')
+        else:
+            _check_value(self.name, str, 'string', 'name', errors)
+        if self.product_system is not None:
+            _check_value(self.product_system, Ref, 'Ref[ProductSystem]', 'product_system', errors)
+        if self.tags is not None:
+            if _check_value(self.tags, list, 'List[string]', 'tags', errors):
+                for i, e in enumerate(self.tags):
+                    _check_value(e, str, 'string', f'tags[{i}]', errors)
+        if self.version is not None:
+            _check_value(self.version, str, 'string', 'version', errors)
+        return errors
 
 
 @dataclass
-class UnitGroup:
+class SocialIndicator:
 
-    id: Optional[str] = None
+    id: Optional[str] = field(default_factory=lambda: str(uuid.uuid4()))
+    """The reference ID (or UUID) of this entity."""
+    activity_quantity: Optional[Ref[FlowProperty]] = None
+    """The quantity of the activity variable."""
+    activity_unit: Optional[Ref[Unit]] = None
+    """The unit of the activity variable."""
+    activity_variable: Optional[str] = None
+    """The name of the activity variable of the indicator."""
     category: Optional[str] = None
-    default_flow_property: Optional[Ref] = None
+    """A full path of the category. Forward slashes are used to separate the
+    segments of this path, e.g. Elementary flows/emissions/air/unspecified.
+    """
     description: Optional[str] = None
+    """The description of the entity."""
+    evaluation_scheme: Optional[str] = None
+    """Documentation of the evaluation scheme of the indicator."""
     last_change: Optional[str] = None
+    """The timestamp when the entity was changed the last time."""
     library: Optional[str] = None
+    """If this entity is part of a library, this field contains the identifier
+    of that library. The identifier is typically just the combination of the
+    library name and version.
+    """
     name: Optional[str] = None
+    """The name of the entity."""
     tags: Optional[List[str]] = None
-    units: Optional[List[Unit]] = None
-    version: Optional[str] = None
+    """A list of optional tags. A tag is just a string which should not contain
+    commas (and other special characters).
+    """
+    unit_of_measurement: Optional[str] = None
+    """The unit in which the indicator is measured."""
+    version: Optional[str] = '01.00.000'
+    """A version number in MAJOR.MINOR.PATCH format where the MINOR and PATCH
+    fields are optional and the fields may have leading zeros (so 01.00.00
+    is the same as 1.0.0 or 1).
+    """
+
+    def __post_init__(self) -> None:
+        if self.version is None:
+            self.version = '01.00.000'
+        if self.last_change is None:
+            self.last_change = datetime.datetime.utcnow().isoformat() + 'Z'
+
+    def to_dict(self) -> Dict[str, Any]:
+        d: Dict[str, Any] = {}
+        d['@type'] = 'SocialIndicator'
+        if self.id is not None:
+            d['@id'] = self.id
+        if self.activity_quantity is not None:
+            d['activityQuantity'] = self.activity_quantity.to_dict()
+        if self.activity_unit is not None:
+            d['activityUnit'] = self.activity_unit.to_dict()
+        if self.activity_variable is not None:
+            d['activityVariable'] = self.activity_variable
+        if self.category is not None:
+            d['category'] = self.category
+        if self.description is not None:
+            d['description'] = self.description
+        if self.evaluation_scheme is not None:
+            d['evaluationScheme'] = self.evaluation_scheme
+        if self.last_change is not None:
+            d['lastChange'] = self.last_change
+        if self.library is not None:
+            d['library'] = self.library
+        if self.name is not None:
+            d['name'] = self.name
+        if self.tags is not None:
+            d['tags'] = self.tags
+        if self.unit_of_measurement is not None:
+            d['unitOfMeasurement'] = self.unit_of_measurement
+        if self.version is not None:
+            d['version'] = self.version
+        return d
+
+    def to_json(self) -> str:
+        return json.dumps(self.to_dict(), indent=2)
+
+    def to_ref(self) -> 'Ref[SocialIndicator]':
+        ref: Ref[SocialIndicator] = Ref(id=self.id, name=self.name)
+        ref.category = self.category
+        ref.model_type = 'SocialIndicator'
+        return ref
+
+    @staticmethod
+    def from_dict(d: Dict[str, Any]) -> 'SocialIndicator':
+        social_indicator = SocialIndicator()
+        if (v := d.get('@id')) is not None:
+            social_indicator.id = v
+        if (v := d.get('activityQuantity')) is not None:
+            social_indicator.activity_quantity = Ref.from_dict(v, 'FlowProperty')
+        if (v := d.get('activityUnit')) is not None:
+            social_indicator.activity_unit = Ref.from_dict(v, 'Unit')
+        if (v := d.get('activityVariable')) is not None:
+            social_indicator.activity_variable = v
+        if (v := d.get('category')) is not None:
+            social_indicator.category = v
+        if (v := d.get('description')) is not None:
+            social_indicator.description = v
+        if (v := d.get('evaluationScheme')) is not None:
+            social_indicator.evaluation_scheme = v
+        if (v := d.get('lastChange')) is not None:
+            social_indicator.last_change = v
+        if (v := d.get('library')) is not None:
+            social_indicator.library = v
+        if (v := d.get('name')) is not None:
+            social_indicator.name = v
+        if (v := d.get('tags')) is not None:
+            social_indicator.tags = v
+        if (v := d.get('unitOfMeasurement')) is not None:
+            social_indicator.unit_of_measurement = v
+        if (v := d.get('version')) is not None:
+            social_indicator.version = v
+        return social_indicator
+
+    @staticmethod
+    def from_json(data: Union[str, bytes]) -> 'SocialIndicator':
+        return SocialIndicator.from_dict(json.loads(data))
+
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.id is None:
+            errors.append('id: value is required')
+        else:
+            _check_value(self.id, str, 'string', 'id', errors)
+        if self.activity_quantity is not None:
+            _check_value(self.activity_quantity, Ref, 'Ref[FlowProperty]', 'activity_quantity', errors)
+        if self.activity_unit is not None:
+            _check_value(self.activity_unit, Ref, 'Ref[Unit]', 'activity_unit', errors)
+        if self.activity_variable is not None:
+            _check_value(self.activity_variable, str, 'string', 'activity_variable', errors)
+        if self.category is not None:
+            _check_value(self.category, str, 'string', 'category', errors)
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.evaluation_scheme is not None:
+            _check_value(self.evaluation_scheme, str, 'string', 'evaluation_scheme', errors)
+        if self.last_change is not None:
+            _check_value(self.last_change, str, 'dateTime', 'last_change', errors)
+        if self.library is not None:
+            _check_value(self.library, str, 'string', 'library', errors)
+        if self.name is None:
+            errors.append('name: value is required')
+        else:
+            _check_value(self.name, str, 'string', 'name', errors)
+        if self.tags is not None:
+            if _check_value(self.tags, list, 'List[string]', 'tags', errors):
+                for i, e in enumerate(self.tags):
+                    _check_value(e, str, 'string', f'tags[{i}]', errors)
+        if self.unit_of_measurement is not None:
+            _check_value(self.unit_of_measurement, str, 'string', 'unit_of_measurement', errors)
+        if self.version is not None:
+            _check_value(self.version, str, 'string', 'version', errors)
+        return errors
+
+
+@dataclass
+class Source:
+    """A source is a literature reference."""
+
+    id: Optional[str] = field(default_factory=lambda: str(uuid.uuid4()))
+    """The reference ID (or UUID) of this entity."""
+    category: Optional[str] = None
+    """A full path of the category. Forward slashes are used to separate the
+    segments of this path, e.g. Elementary flows/emissions/air/unspecified.
+    """
+    description: Optional[str] = None
+    """The description of the entity."""
+    external_file: Optional[str] = None
+    """A direct link (relative or absolute URL) to the source file."""
+    last_change: Optional[str] = None
+    """The timestamp when the entity was changed the last time."""
+    library: Optional[str] = None
+    """If this entity is part of a library, this field contains the identifier
+    of that library. The identifier is typically just the combination of the
+    library name and version.
+    """
+    name: Optional[str] = None
+    """The name of the entity."""
+    tags: Optional[List[str]] = None
+    """A list of optional tags. A tag is just a string which should not contain
+    commas (and other special characters).
+    """
+    text_reference: Optional[str] = None
+    """The full text reference of the source."""
+    url: Optional[str] = None
+    """A URL that points to the source."""
+    version: Optional[str] = '01.00.000'
+    """A version number in MAJOR.MINOR.PATCH format where the MINOR and PATCH
+    fields are optional and the fields may have leading zeros (so 01.00.00
+    is the same as 1.0.0 or 1).
+    """
+    year: Optional[int] = None
+    """The publication year of the source."""
 
-    def __post_init__(self):
+    def __post_init__(self) -> None:
+        if self.version is None:
+            self.version = '01.00.000'
+        if self.last_change is None:
+            self.last_change = datetime.datetime.utcnow().isoformat() + 'Z'
+
+    def to_dict(self) -> Dict[str, Any]:
+        d: Dict[str, Any] = {}
+        d['@type'] = 'Source'
+        if self.id is not None:
+            d['@id'] = self.id
+        if self.category is not None:
+            d['category'] = self.category
+        if self.description is not None:
+            d['description'] = self.description
+        if self.external_file is not None:
+            d['externalFile'] = self.external_file
+        if self.last_change is not None:
+            d['lastChange'] = self.last_change
+        if self.library is not None:
+            d['library'] = self.library
+        if self.name is not None:
+            d['name'] = self.name
+        if self.tags is not None:
+            d['tags'] = self.tags
+        if self.text_reference is not None:
+            d['textReference'] = self.text_reference
+        if self.url is not None:
+            d['url'] = self.url
+        if self.version is not None:
+            d['version'] = self.version
+        if self.year is not None:
+            d['year'] = self.year
+        return d
+
+    def to_json(self) -> str:
+        return json.dumps(self.to_dict(), indent=2)
+
+    def to_ref(self) -> 'Ref[Source]':
+        ref: Ref[Source] = Ref(id=self.id, name=self.name)
+        ref.category = self.category
+        ref.model_type = 'Source'
+        return ref
+
+    @staticmethod
+    def from_dict(d: Dict[str, Any]) -> 'Source':
+        source = Source()
+        if (v := d.get('@id')) is not None:
+            source.id = v
+        if (v := d.get('category')) is not None:
+            source.category = v
+        if (v := d.get('description')) is not None:
+            source.description = v
+        if (v := d.get('externalFile')) is not None:
+            source.external_file = v
+        if (v := d.get('lastChange')) is not None:
+            source.last_change = v
+        if (v := d.get('library')) is not None:
+            source.library = v
+        if (v := d.get('name')) is not None:
+            source.name = v
+        if (v := d.get('tags')) is not None:
+            source.tags = v
+        if (v := d.get('textReference')) is not None:
+            source.text_reference = v
+        if (v := d.get('url')) is not None:
+            source.url = v
+        if (v := d.get('version')) is not None:
+            source.version = v
+        if (v := d.get('year')) is not None:
+            source.year = v
+        return source
+
+    @staticmethod
+    def from_json(data: Union[str, bytes]) -> 'Source':
+        return Source.from_dict(json.loads(data))
+
+    def validate(self) -> List[str]:
+        errors: List[str] = []
         if self.id is None:
-            self.id = str(uuid.uuid4())
+            errors.append('id: value is required')
+        else:
+            _check_value(self.id, str, 'string', 'id', errors)
+        if self.category is not None:
+            _check_value(self.category, str, 'string', 'category', errors)
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.external_file is not None:
+            _check_value(self.external_file, str, 'string', 'external_file', errors)
+        if self.last_change is not None:
+            _check_value(self.last_change, str, 'dateTime', 'last_change', errors)
+        if self.library is not None:
+            _check_value(self.library, str, 'string', 'library', errors)
+        if self.name is None:
+            errors.append('name: value is required')
+        else:
+            _check_value(self.name, str, 'string', 'name', errors)
+        if self.tags is not None:
+            if _check_value(self.tags, list, 'List[string]', 'tags', errors):
+                for i, e in enumerate(self.tags):
+                    _check_value(e, str, 'string', f'tags[{i}]', errors)
+        if self.text_reference is not None:
+            _check_value(self.text_reference, str, 'string', 'text_reference', errors)
+        if self.url is not None:
+            _check_value(self.url, str, 'string', 'url', errors)
+        if self.version is not None:
+            _check_value(self.version, str, 'string', 'version', errors)
+        if self.year is not None:
+            _check_value(self.year, int, 'integer', 'year', errors)
+        return errors
+
+
+@dataclass
+class UnitGroup:
+    """A group of units that can be converted into each other."""
+
+    id: Optional[str] = field(default_factory=lambda: str(uuid.uuid4()))
+    """The reference ID (or UUID) of this entity."""
+    category: Optional[str] = None
+    """A full path of the category. Forward slashes are used to separate the
+    segments of this path, e.g. Elementary flows/emissions/air/unspecified.
+    """
+    default_flow_property: Optional[Ref[FlowProperty]] = None
+    """Some LCA data formats do not have the concept of flow properties or
+    quantities. This field provides a default link to a flow property for
+    units that are contained in this group.
+    """
+    description: Optional[str] = None
+    """The description of the entity."""
+    last_change: Optional[str] = None
+    """The timestamp when the entity was changed the last time."""
+    library: Optional[str] = None
+    """If this entity is part of a library, this field contains the identifier
+    of that library. The identifier is typically just the combination of the
+    library name and version.
+    """
+    name: Optional[str] = None
+    """The name of the entity."""
+    tags: Optional[List[str]] = None
+    """A list of optional tags. A tag is just a string which should not contain
+    commas (and other special characters).
+    """
+    units: Optional[List[Unit]] = None
+    """The units of the unit group."""
+    version: Optional[str] = '01.00.000'
+    """A version number in MAJOR.MINOR.PATCH format where the MINOR and PATCH
+    fields are optional and the fields may have leading zeros (so 01.00.00
+    is the same as 1.0.0 or 1).
+    """
+
+    def __post_init__(self) -> None:
         if self.version is None:
             self.version = '01.00.000'
         if self.last_change is None:
@@ -3240,33 +5167,33 @@ class UnitGroup:
     def to_dict(self) -> Dict[str, Any]:
         d: Dict[str, Any] = {}
         d['@type'] = 'UnitGroup'
-        if self.id:
+        if self.id is not None:
             d['@id'] = self.id
-        if self.category:
+        if self.category is not None:
             d['category'] = self.category
-        if self.default_flow_property:
+        if self.default_flow_property is not None:
             d['defaultFlowProperty'] = self.default_flow_property.to_dict()
-        if self.description:
+        if self.description is not None:
             d['description'] = self.description
-        if self.last_change:
+        if self.last_change is not None:
             d['lastChange'] = self.last_change
-        if self.library:
+        if self.library is not None:
             d['library'] = self.library
-        if self.name:
+        if self.name is not None:
             d['name'] = self.name
-        if self.tags:
+        if self.tags is not None:
             d['tags'] = self.tags
-        if self.units:
+        if self.units is not None:
             d['units'] = [e.to_dict() for e in self.units]
-        if self.version:
+        if self.version is not None:
             d['version'] = self.version
         return d
 
     def to_json(self) -> str:
         return json.dumps(self.to_dict(), indent=2)
 
-    def to_ref(self) -> 'Ref':
-        ref = Ref(id=self.id, name=self.name)
+    def to_ref(self) -> 'Ref[UnitGroup]':
+        ref: Ref[UnitGroup] = Ref(id=self.id, name=self.name)
         ref.category = self.category
         ref.model_type = 'UnitGroup'
         return ref
@@ -3274,27 +5201,25 @@ class UnitGroup:
     @staticmethod
     def from_dict(d: Dict[str, Any]) -> 'UnitGroup':
         unit_group = UnitGroup()
-        if v := d.get('@type'):
-            unit_group.schema_type = v
-        if v := d.get('@id'):
+        if (v := d.get('@id')) is not None:
             unit_group.id = v
-        if v := d.get('category'):
+        if (v := d.get('category')) is not None:
             unit_group.category = v
-        if v := d.get('defaultFlowProperty'):
-            unit_group.default_flow_property = Ref[FlowProperty].from_dict(v)
-        if v := d.get('description'):
+        if (v := d.get('defaultFlowProperty')) is not None:
+            unit_group.default_flow_property = Ref.from_dict(v, 'FlowProperty')
+        if (v := d.get('description')) is not None:
             unit_group.description = v
-        if v := d.get('lastChange'):
+        if (v := d.get('lastChange')) is not None:
             unit_group.last_change = v
-        if v := d.get('library'):
+        if (v := d.get('library')) is not None:
             unit_group.library = v
-        if v := d.get('name'):
+        if (v := d.get('name')) is not None:
             unit_group.name = v
-        if v := d.get('tags'):
+        if (v := d.get('tags')) is not None:
             unit_group.tags = v
-        if v := d.get('units'):
+        if (v := d.get('units')) is not None:
             unit_group.units = [Unit.from_dict(e) for e in v]
-        if v := d.get('version'):
+        if (v := d.get('version')) is not None:
             unit_group.version = v
         return unit_group
 
@@ -3302,6 +5227,38 @@ class UnitGroup:
     def from_json(data: Union[str, bytes]) -> 'UnitGroup':
         return UnitGroup.from_dict(json.loads(data))
 
+    def validate(self) -> List[str]:
+        errors: List[str] = []
+        if self.id is None:
+            errors.append('id: value is required')
+        else:
+            _check_value(self.id, str, 'string', 'id', errors)
+        if self.category is not None:
+            _check_value(self.category, str, 'string', 'category', errors)
+        if self.default_flow_property is not None:
+            _check_value(self.default_flow_property, Ref, 'Ref[FlowProperty]', 'default_flow_property', errors)
+        if self.description is not None:
+            _check_value(self.description, str, 'string', 'description', errors)
+        if self.last_change is not None:
+            _check_value(self.last_change, str, 'dateTime', 'last_change', errors)
+        if self.library is not None:
+            _check_value(self.library, str, 'string', 'library', errors)
+        if self.name is None:
+            errors.append('name: value is required')
+        else:
+            _check_value(self.name, str, 'string', 'name', errors)
+        if self.tags is not None:
+            if _check_value(self.tags, list, 'List[string]', 'tags', errors):
+                for i, e in enumerate(self.tags):
+                    _check_value(e, str, 'string', f'tags[{i}]', errors)
+        if self.units is not None:
+            if _check_value(self.units, list, 'List[Unit]', 'units', errors):
+                for i, e in enumerate(self.units):
+                    _check_value(e, Unit, 'Unit', f'units[{i}]', errors)
+        if self.version is not None:
+            _check_value(self.version, str, 'string', 'version', errors)
+        return errors
+
 
 RootEntity = Union[
     Actor,
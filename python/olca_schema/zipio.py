@@ -1,100 +1,281 @@
+# DO NOT CHANGE THIS CODE AS THIS IS GENERATED AUTOMATICALLY
+
+# This module contains the reader and writer of zip packages with data sets
+# in the openLCA schema format. The data sets are stored in folders by type.
+
+import json
 import zipfile
 
-import olca_schema as schema
+from typing import Any, Dict, Iterator, List, Optional, Type, TypeVar
+
+from . import schema
+
+E = TypeVar('E')
 
-from typing import Optional
+_FOLDERS: Dict[type, str] = {
+    schema.Actor: 'actors',
+    schema.Category: 'categories',
+    schema.Currency: 'currencies',
+    schema.DQSystem: 'dq_systems',
+    schema.Epd: 'epds',
+    schema.Flow: 'flows',
+    schema.FlowMap: 'flow_maps',
+    schema.FlowProperty: 'flow_properties',
+    schema.ImpactCategory: 'lcia_categories',
+    schema.ImpactMethod: 'lcia_methods',
+    schema.Location: 'locations',
+    schema.Parameter: 'parameters',
+    schema.Process: 'processes',
+    schema.ProductSystem: 'product_systems',
+    schema.Project: 'projects',
+    schema.Result: 'results',
+    schema.SocialIndicator: 'social_indicators',
+    schema.Source: 'sources',
+    schema.UnitGroup: 'unit_groups',
+}
 
 
 class ZipWriter:
 
-    def __init__(self, file_name: str):
+    def __init__(self, file_name: str) -> None:
         self.__zip = zipfile.ZipFile(
             file_name, mode='a', compression=zipfile.ZIP_DEFLATED)
         if 'olca-schema.json' not in self.__zip.namelist():
-            self.__zip.writestr('olca-schema.json', '{"version": 2}')
+            self.__zip.writestr('olca-schema.json', json.dumps({
+                'version': 2,
+                'schemaVersion': schema.SCHEMA_VERSION,
+            }))
 
-    def __enter__(self):
+    def __enter__(self) -> 'ZipWriter':
         return self
 
-    def __exit__(self, type, value, traceback):
+    def __exit__(self, *args: Any) -> None:
         self.close()
 
-    def close(self):
+    def close(self) -> None:
         self.__zip.close()
 
-    def write(self, entity: schema.RootEntity):
+    def write(self, entity: schema.RootEntity) -> None:
         if entity.id is None or entity.id == '':
             raise ValueError('entity must have an ID')
-        folder = _folder_of_entity(entity)
+        folder = _folder_of_class(type(entity))
         path = f'{folder}/{entity.id}.json'
         self.__zip.writestr(path, entity.to_json())
 
 
 class ZipReader:
 
-    def __init__(self, file_name: str):
+    def __init__(self, file_name: str) -> None:
         self.__zip = zipfile.ZipFile(file_name, mode='r')
 
-    def __enter__(self):
+    def __enter__(self) -> 'ZipReader':
         return self
 
-    def __exit__(self, type, value, traceback):
+    def __exit__(self, *args: Any) -> None:
         self.close()
 
-    def close(self):
+    def close(self) -> None:
         self.__zip.close()
 
-    def read(self, class_type: type, uid: str) -> Optional[schema.RootEntity]:
+    def read(self, class_type: Type[E], uid: str) -> Optional[E]:
         folder = _folder_of_class(class_type)
         path = f'{folder}/{uid}.json'
         if path not in self.__zip.namelist():
             return None
         data = self.__zip.read(path)
-        return class_type.from_json(data)
+        return class_type.from_json(data)  # type: ignore
+
+    def ids(self, class_type: type) -> List[str]:
+        folder = _folder_of_class(class_type)
+        ids: List[str] = []
+        for path in self.__zip.namelist():
+            if path.startswith(folder + '/') and path.endswith('.json'):
+                ids.append(path[len(folder) + 1:-5])
+        return ids
+
+    def iter_all(self, class_type: Type[E]) -> Iterator[E]:
+        for uid in self.ids(class_type):
+            entity = self.read(class_type, uid)
+            if entity is not None:
+                yield entity
+
+    def read_all(self, class_type: Type[E]) -> List[E]:
+        return list(self.iter_all(class_type))
 
     def read_actor(self, uid: str) -> Optional[schema.Actor]:
         return self.read(schema.Actor, uid)
 
+    def iter_actors(self) -> Iterator[schema.Actor]:
+        return self.iter_all(schema.Actor)
+
+    def read_all_actors(self) -> List[schema.Actor]:
+        return self.read_all(schema.Actor)
+
+    def read_category(self, uid: str) -> Optional[schema.Category]:
+        return self.read(schema.Category, uid)
+
+    def iter_categories(self) -> Iterator[schema.Category]:
+        return self.iter_all(schema.Category)
+
+    def read_all_categories(self) -> List[schema.Category]:
+        return self.read_all(schema.Category)
+
+    def read_currency(self, uid: str) -> Optional[schema.Currency]:
+        return self.read(schema.Currency, uid)
+
+    def iter_currencies(self) -> Iterator[schema.Currency]:
+        return self.iter_all(schema.Currency)
+
+    def read_all_currencies(self) -> List[schema.Currency]:
+        return self.read_all(schema.Currency)
+
+    def read_dq_system(self, uid: str) -> Optional[schema.DQSystem]:
+        return self.read(schema.DQSystem, uid)
+
+    def iter_dq_systems(self) -> Iterator[schema.DQSystem]:
+        return self.iter_all(schema.DQSystem)
+
+    def read_all_dq_systems(self) -> List[schema.DQSystem]:
+        return self.read_all(schema.DQSystem)
+
+    def read_epd(self, uid: str) -> Optional[schema.Epd]:
+        return self.read(schema.Epd, uid)
+
+    def iter_epds(self) -> Iterator[schema.Epd]:
+        return self.iter_all(schema.Epd)
+
+    def read_all_epds(self) -> List[schema.Epd]:
+        return self.read_all(schema.Epd)
+
+    def read_flow(self, uid: str) -> Optional[schema.Flow]:
+        return self.read(schema.Flow, uid)
+
+    def iter_flows(self) -> Iterator[schema.Flow]:
+        return self.iter_all(schema.Flow)
+
+    def read_all_flows(self) -> List[schema.Flow]:
+        return self.read_all(schema.Flow)
+
+    def read_flow_map(self, uid: str) -> Optional[schema.FlowMap]:
+        return self.read(schema.FlowMap, uid)
+
+    def iter_flow_maps(self) -> Iterator[schema.FlowMap]:
+        return self.iter_all(schema.FlowMap)
+
+    def read_all_flow_maps(self) -> List[schema.FlowMap]:
+        return self.read_all(schema.FlowMap)
+
+    def read_flow_property(self, uid: str) -> Optional[schema.FlowProperty]:
+        return self.read(schema.FlowProperty, uid)
+
+    def iter_flow_properties(self) -> Iterator[schema.FlowProperty]:
+        return self.iter_all(schema.FlowProperty)
+
+    def read_all_flow_properties(self) -> List[schema.FlowProperty]:
+        return self.read_all(schema.FlowProperty)
+
+    def read_impact_category(self, uid: str) -> Optional[schema.ImpactCategory]:
+        return self.read(schema.ImpactCategory, uid)
+
+    def iter_impact_categories(self) -> Iterator[schema.ImpactCategory]:
+        return self.iter_all(schema.ImpactCategory)
+
+    def read_all_impact_categories(self) -> List[schema.ImpactCategory]:
+        return self.read_all(schema.ImpactCategory)
+
+    def read_impact_method(self, uid: str) -> Optional[schema.ImpactMethod]:
+        return self.read(schema.ImpactMethod, uid)
+
+    def iter_impact_methods(self) -> Iterator[schema.ImpactMethod]:
+        return self.iter_all(schema.ImpactMethod)
+
+    def read_all_impact_methods(self) -> List[schema.ImpactMethod]:
+        return self.read_all(schema.ImpactMethod)
+
+    def read_location(self, uid: str) -> Optional[schema.Location]:
+        return self.read(schema.Location, uid)
+
+    def iter_locations(self) -> Iterator[schema.Location]:
+        return self.iter_all(schema.Location)
+
+    def read_all_locations(self) -> List[schema.Location]:
+        return self.read_all(schema.Location)
+
+    def read_parameter(self, uid: str) -> Optional[schema.Parameter]:
+        return self.read(schema.Parameter, uid)
+
+    def iter_parameters(self) -> Iterator[schema.Parameter]:
+        return self.iter_all(schema.Parameter)
+
+    def read_all_parameters(self) -> List[schema.Parameter]:
+        return self.read_all(schema.Parameter)
+
+    def read_process(self, uid: str) -> Optional[schema.Process]:
+        return self.read(schema.Process, uid)
+
+    def iter_processes(self) -> Iterator[schema.Process]:
+        return self.iter_all(schema.Process)
+
+    def read_all_processes(self) -> List[schema.Process]:
+        return self.read_all(schema.Process)
+
+    def read_product_system(self, uid: str) -> Optional[schema.ProductSystem]:
+        return self.read(schema.ProductSystem, uid)
+
+    def iter_product_systems(self) -> Iterator[schema.ProductSystem]:
+        return self.iter_all(schema.ProductSystem)
+
+    def read_all_product_systems(self) -> List[schema.ProductSystem]:
+        return self.read_all(schema.ProductSystem)
+
+    def read_project(self, uid: str) -> Optional[schema.Project]:
+        return self.read(schema.Project, uid)
+
+    def iter_projects(self) -> Iterator[schema.Project]:
+        return self.iter_all(schema.Project)
+
+    def read_all_projects(self) -> List[schema.Project]:
+        return self.read_all(schema.Project)
+
+    def read_result(self, uid: str) -> Optional[schema.Result]:
+        return self.read(schema.Result, uid)
+
+    def iter_results(self) -> Iterator[schema.Result]:
+        return self.iter_all(schema.Result)
+
+    def read_all_results(self) -> List[schema.Result]:
+        return self.read_all(schema.Result)
+
+    def read_social_indicator(self, uid: str) -> Optional[schema.SocialIndicator]:
+        return self.read(schema.SocialIndicator, uid)
+
+    def iter_social_indicators(self) -> Iterator[schema.SocialIndicator]:
+        return self.iter_all(schema.SocialIndicator)
+
+    def read_all_social_indicators(self) -> List[schema.SocialIndicator]:
+        return self.read_all(schema.SocialIndicator)
+
+    def read_source(self, uid: str) -> Optional[schema.Source]:
+        return self.read(schema.Source, uid)
+
+    def iter_sources(self) -> Iterator[schema.Source]:
+        return self.iter_all(schema.Source)
+
+    def read_all_sources(self) -> List[schema.Source]:
+        return self.read_all(schema.Source)
+
+    def read_unit_group(self, uid: str) -> Optional[schema.UnitGroup]:
+        return self.read(schema.UnitGroup, uid)
+
+    def iter_unit_groups(self) -> Iterator[schema.UnitGroup]:
+        return self.iter_all(schema.UnitGroup)
 
-def _folder_of_entity(entity: schema.RootEntity) -> str:
-    if entity is None:
-        raise ValueError("unknown root entity type: " + entity)
-    return _folder_of_class(type(entity))
+    def read_all_unit_groups(self) -> List[schema.UnitGroup]:
+        return self.read_all(schema.UnitGroup)
 
 
 def _folder_of_class(t: type) -> str:
-    if t == schema.Actor:
-        return 'actors'
-    if t == schema.Currency:
-        return 'currencies'
-    if t == schema.DQSystem:
-        return 'dq_systems'
-    if t == schema.Epd:
-        return 'epds'
-    if t == schema.Flow:
-        return 'flows'
-    if t == schema.FlowProperty:
-        return 'flow_properties'
-    if t == schema.ImpactCategory:
-        return 'lcia_categories'
-    if t == schema.ImpactMethod:
-        return 'lcia_methods'
-    if t == schema.Location:
-        return 'locations'
-    if t == schema.Parameter:
-        return 'parameters'
-    if t == schema.Process:
-        return 'processes'
-    if t == schema.ProductSystem:
-        return 'product_systems'
-    if t == schema.Project:
-        return 'projects'
-    if t == schema.Result:
-        return 'results'
-    if t == schema.SocialIndicator:
-        return 'social_indicators'
-    if t == schema.Source:
-        return 'sources'
-    if t == schema.UnitGroup:
-        return 'unit_groups'
-    raise ValueError(f'not a known root entity type: {t}')
+    folder = _FOLDERS.get(t)
+    if folder is None:
+        raise ValueError(f'not a known root entity type: {t}')
+    return folder
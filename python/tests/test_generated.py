@@ -0,0 +1,1368 @@
+# DO NOT CHANGE THIS CODE AS THIS IS GENERATED AUTOMATICALLY
+
+# This module contains round-trip tests of the classes of the
+# generated module `olca_schema.schema` with sample values of all
+# properties.
+
+import datetime
+import json
+import unittest
+import warnings
+
+import olca_schema.schema as schema
+
+
+def _ref_of(model_type):
+    ref = _sample_ref()
+    ref.model_type = model_type
+    return ref
+
+
+def _sample_allocation_factor():
+    return schema.AllocationFactor(
+        allocation_type=schema.AllocationType.PHYSICAL_ALLOCATION,
+        exchange=_sample_exchange_ref(),
+        formula='formula',
+        product=_ref_of('Flow'),
+        value=42.5,
+    )
+
+
+def _sample_calculation_setup():
+    return schema.CalculationSetup(
+        allocation=schema.AllocationType.PHYSICAL_ALLOCATION,
+        amount=42.5,
+        calculation_type=schema.CalculationType.SIMPLE_CALCULATION,
+        flow_property=_ref_of('FlowProperty'),
+        impact_method=_ref_of('ImpactMethod'),
+        number_of_runs=42,
+        nw_set=_ref_of('NwSet'),
+        parameters=[_sample_parameter_redef(), _sample_parameter_redef()],
+        target=_sample_ref(),
+        unit=_ref_of('Unit'),
+        with_costs=True,
+        with_regionalization=True,
+    )
+
+
+def _sample_dq_indicator():
+    return schema.DQIndicator(
+        name='name',
+        position=42,
+        scores=[_sample_dq_score(), _sample_dq_score()],
+    )
+
+
+def _sample_dq_score():
+    return schema.DQScore(
+        description='description',
+        label='label',
+        position=42,
+        uncertainty=42.5,
+    )
+
+
+def _sample_epd_module():
+    return schema.EpdModule(
+        name='name',
+        result=_ref_of('Result'),
+    )
+
+
+def _sample_epd_product():
+    return schema.EpdProduct(
+        amount=42.5,
+        flow=_ref_of('Flow'),
+        flow_property=_ref_of('FlowProperty'),
+        unit=_ref_of('Unit'),
+    )
+
+
+def _sample_exchange():
+    return schema.Exchange(
+        amount=42.5,
+        amount_formula='amount_formula',
+        base_uncertainty=42.5,
+        cost_formula='cost_formula',
+        cost_value=42.5,
+        currency=_ref_of('Currency'),
+        default_provider=_ref_of('Process'),
+        description='description',
+        dq_entry='dq_entry',
+        flow=_ref_of('Flow'),
+        flow_property=_ref_of('FlowProperty'),
+        internal_id=42,
+        is_avoided_product=True,
+        is_input=True,
+        is_quantitative_reference=True,
+        location=_ref_of('Location'),
+        uncertainty=_sample_uncertainty(),
+        unit=_ref_of('Unit'),
+    )
+
+
+def _sample_exchange_ref():
+    return schema.ExchangeRef(
+        internal_id=42,
+    )
+
+
+def _sample_flow_map_entry():
+    return schema.FlowMapEntry(
+        conversion_factor=42.5,
+        from_=_sample_flow_map_ref(),
+        to=_sample_flow_map_ref(),
+    )
+
+
+def _sample_flow_map_ref():
+    return schema.FlowMapRef(
+        flow=_ref_of('Flow'),
+        flow_property=_ref_of('FlowProperty'),
+        provider=_ref_of('Process'),
+        unit=_ref_of('Unit'),
+    )
+
+
+def _sample_flow_property_factor():
+    return schema.FlowPropertyFactor(
+        conversion_factor=42.5,
+        flow_property=_ref_of('FlowProperty'),
+        is_ref_flow_property=True,
+    )
+
+
+def _sample_flow_result():
+    return schema.FlowResult(
+        amount=42.5,
+        description='description',
+        flow=_ref_of('Flow'),
+        flow_property=_ref_of('FlowProperty'),
+        is_input=True,
+        is_ref_flow=True,
+        location=_ref_of('Location'),
+        unit=_ref_of('Unit'),
+    )
+
+
+def _sample_impact_factor():
+    return schema.ImpactFactor(
+        flow=_ref_of('Flow'),
+        flow_property=_ref_of('FlowProperty'),
+        formula='formula',
+        location=_ref_of('Location'),
+        uncertainty=_sample_uncertainty(),
+        unit=_ref_of('Unit'),
+        value=42.5,
+    )
+
+
+def _sample_impact_result():
+    return schema.ImpactResult(
+        amount=42.5,
+        description='description',
+        indicator=_ref_of('ImpactCategory'),
+    )
+
+
+def _sample_nw_factor():
+    return schema.NwFactor(
+        impact_category=_ref_of('ImpactCategory'),
+        normalisation_factor=42.5,
+        weighting_factor=42.5,
+    )
+
+
+def _sample_nw_set():
+    return schema.NwSet(
+        id='id',
+        description='description',
+        factors=[_sample_nw_factor(), _sample_nw_factor()],
+        name='name',
+        weighted_score_unit='weighted_score_unit',
+    )
+
+
+def _sample_parameter_redef():
+    return schema.ParameterRedef(
+        context=_sample_ref(),
+        description='description',
+        is_protected=True,
+        name='name',
+        uncertainty=_sample_uncertainty(),
+        value=42.5,
+    )
+
+
+def _sample_parameter_redef_set():
+    return schema.ParameterRedefSet(
+        description='description',
+        is_baseline=True,
+        name='name',
+        parameters=[_sample_parameter_redef(), _sample_parameter_redef()],
+    )
+
+
+def _sample_process_documentation():
+    return schema.ProcessDocumentation(
+        completeness_description='completeness_description',
+        creation_date='2022-05-04T12:30:00Z',
+        data_collection_description='data_collection_description',
+        data_documentor=_ref_of('Actor'),
+        data_generator=_ref_of('Actor'),
+        data_selection_description='data_selection_description',
+        data_set_owner=_ref_of('Actor'),
+        data_treatment_description='data_treatment_description',
+        geography_description='geography_description',
+        intended_application='intended_application',
+        inventory_method_description='inventory_method_description',
+        is_copyright_protected=True,
+        modeling_constants_description='modeling_constants_description',
+        project_description='project_description',
+        publication=_ref_of('Source'),
+        restrictions_description='restrictions_description',
+        review_details='review_details',
+        reviewer=_ref_of('Actor'),
+        sampling_description='sampling_description',
+        sources=[_ref_of('Source'), _ref_of('Source')],
+        technology_description='technology_description',
+        time_description='time_description',
+        valid_from='2022-05-04',
+        valid_until='2022-05-04',
+    )
+
+
+def _sample_process_link():
+    return schema.ProcessLink(
+        exchange=_sample_exchange_ref(),
+        flow=_ref_of('Flow'),
+        process=_ref_of('Process'),
+        provider=_sample_ref(),
+    )
+
+
+def _sample_project_variant():
+    return schema.ProjectVariant(
+        allocation_method=schema.AllocationType.PHYSICAL_ALLOCATION,
+        amount=42.5,
+        description='description',
+        is_disabled=True,
+        name='name',
+        parameter_redefs=[
+            _sample_parameter_redef(),
+            _sample_parameter_redef(),
+        ],
+        product_system=_ref_of('ProductSystem'),
+        unit=_ref_of('Unit'),
+    )
+
+
+def _sample_ref():
+    return schema.Ref(
+        id='id',
+        category='category',
+        description='description',
+        flow_type=schema.FlowType.ELEMENTARY_FLOW,
+        library='library',
+        location='location',
+        name='name',
+        process_type=schema.ProcessType.LCI_RESULT,
+        ref_unit='ref_unit',
+    )
+
+
+def _sample_social_aspect():
+    return schema.SocialAspect(
+        activity_value=42.5,
+        comment='comment',
+        quality='quality',
+        raw_amount='raw_amount',
+        risk_level=schema.RiskLevel.NO_OPPORTUNITY,
+        social_indicator=_ref_of('SocialIndicator'),
+        source=_ref_of('Source'),
+    )
+
+
+def _sample_uncertainty():
+    return schema.Uncertainty(
+        distribution_type=schema.UncertaintyType.LOG_NORMAL_DISTRIBUTION,
+        geom_mean=42.5,
+        geom_mean_formula='geom_mean_formula',
+        geom_sd=42.5,
+        geom_sd_formula='geom_sd_formula',
+        maximum=42.5,
+        maximum_formula='maximum_formula',
+        mean=42.5,
+        mean_formula='mean_formula',
+        minimum=42.5,
+        minimum_formula='minimum_formula',
+        mode=42.5,
+        mode_formula='mode_formula',
+        sd=42.5,
+        sd_formula='sd_formula',
+    )
+
+
+def _sample_unit():
+    return schema.Unit(
+        id='id',
+        conversion_factor=42.5,
+        description='description',
+        is_ref_unit=True,
+        name='name',
+        synonyms=['synonyms', 'synonyms 2'],
+    )
+
+
+def _sample_actor():
+    return schema.Actor(
+        id='id',
+        address='address',
+        category='category',
+        city='city',
+        country='country',
+        description='description',
+        email='email',
+        last_change='2022-05-04T12:30:00Z',
+        library='library',
+        name='name',
+        tags=['tags', 'tags 2'],
+        telefax='telefax',
+        telephone='telephone',
+        version='version',
+        website='website',
+        zip_code='zip_code',
+    )
+
+
+def _sample_category():
+    return schema.Category(
+        id='id',
+        category='category',
+        description='description',
+        last_change='2022-05-04T12:30:00Z',
+        library='library',
+        model_type=schema.ModelType.ACTOR,
+        name='name',
+        tags=['tags', 'tags 2'],
+        version='version',
+    )
+
+
+def _sample_currency():
+    return schema.Currency(
+        id='id',
+        category='category',
+        code='code',
+        conversion_factor=42.5,
+        description='description',
+        last_change='2022-05-04T12:30:00Z',
+        library='library',
+        name='name',
+        ref_currency=_ref_of('Currency'),
+        tags=['tags', 'tags 2'],
+        version='version',
+    )
+
+
+def _sample_dq_system():
+    return schema.DQSystem(
+        id='id',
+        category='category',
+        description='description',
+        has_uncertainties=True,
+        indicators=[_sample_dq_indicator(), _sample_dq_indicator()],
+        last_change='2022-05-04T12:30:00Z',
+        library='library',
+        name='name',
+        source=_ref_of('Source'),
+        tags=['tags', 'tags 2'],
+        version='version',
+    )
+
+
+def _sample_epd():
+    return schema.Epd(
+        id='id',
+        category='category',
+        description='description',
+        last_change='2022-05-04T12:30:00Z',
+        library='library',
+        manufacturer=_ref_of('Actor'),
+        modules=[_sample_epd_module(), _sample_epd_module()],
+        name='name',
+        pcr=_ref_of('Source'),
+        product=_sample_epd_product(),
+        program_operator=_ref_of('Actor'),
+        tags=['tags', 'tags 2'],
+        urn='urn',
+        verifier=_ref_of('Actor'),
+        version='version',
+    )
+
+
+def _sample_flow():
+    return schema.Flow(
+        id='id',
+        cas='cas',
+        category='category',
+        description='description',
+        flow_properties=[
+            _sample_flow_property_factor(),
+            _sample_flow_property_factor(),
+        ],
+        flow_type=schema.FlowType.ELEMENTARY_FLOW,
+        formula='formula',
+        is_infrastructure_flow=True,
+        last_change='2022-05-04T12:30:00Z',
+        library='library',
+        location=_ref_of('Location'),
+        name='name',
+        synonyms='synonyms',
+        tags=['tags', 'tags 2'],
+        version='version',
+    )
+
+
+def _sample_flow_map():
+    return schema.FlowMap(
+        id='id',
+        category='category',
+        description='description',
+        last_change='2022-05-04T12:30:00Z',
+        library='library',
+        mappings=[_sample_flow_map_entry(), _sample_flow_map_entry()],
+        name='name',
+        source=_sample_ref(),
+        tags=['tags', 'tags 2'],
+        target=_sample_ref(),
+        version='version',
+    )
+
+
+def _sample_flow_property():
+    return schema.FlowProperty(
+        id='id',
+        category='category',
+        description='description',
+        flow_property_type=schema.FlowPropertyType.ECONOMIC_QUANTITY,
+        last_change='2022-05-04T12:30:00Z',
+        library='library',
+        name='name',
+        tags=['tags', 'tags 2'],
+        unit_group=_ref_of('UnitGroup'),
+        version='version',
+    )
+
+
+def _sample_impact_category():
+    return schema.ImpactCategory(
+        id='id',
+        category='category',
+        code='code',
+        description='description',
+        impact_factors=[_sample_impact_factor(), _sample_impact_factor()],
+        last_change='2022-05-04T12:30:00Z',
+        library='library',
+        name='name',
+        parameters=[_sample_parameter(), _sample_parameter()],
+        ref_unit='ref_unit',
+        source=_ref_of('Source'),
+        tags=['tags', 'tags 2'],
+        version='version',
+    )
+
+
+def _sample_impact_method():
+    return schema.ImpactMethod(
+        id='id',
+        category='category',
+        code='code',
+        description='description',
+        impact_categories=[
+            _ref_of('ImpactCategory'),
+            _ref_of('ImpactCategory'),
+        ],
+        last_change='2022-05-04T12:30:00Z',
+        library='library',
+        name='name',
+        nw_sets=[_sample_nw_set(), _sample_nw_set()],
+        source=_ref_of('Source'),
+        tags=['tags', 'tags 2'],
+        version='version',
+    )
+
+
+def _sample_location():
+    return schema.Location(
+        id='id',
+        category='category',
+        code='code',
+        description='description',
+        geometry={'type': 'Point', 'coordinates': [13.4, 52.5]},
+        last_change='2022-05-04T12:30:00Z',
+        latitude=42.5,
+        library='library',
+        longitude=42.5,
+        name='name',
+        tags=['tags', 'tags 2'],
+        version='version',
+    )
+
+
+def _sample_parameter():
+    return schema.Parameter(
+        id='id',
+        category='category',
+        description='description',
+        formula='formula',
+        is_input_parameter=True,
+        last_change='2022-05-04T12:30:00Z',
+        library='library',
+        name='name',
+        parameter_scope=schema.ParameterScope.PROCESS_SCOPE,
+        tags=['tags', 'tags 2'],
+        uncertainty=_sample_uncertainty(),
+        value=42.5,
+        version='version',
+    )
+
+
+def _sample_process():
+    return schema.Process(
+        id='id',
+        allocation_factors=[
+            _sample_allocation_factor(),
+            _sample_allocation_factor(),
+        ],
+        category='category',
+        default_allocation_method=schema.AllocationType.PHYSICAL_ALLOCATION,
+        description='description',
+        dq_entry='dq_entry',
+        dq_system=_ref_of('DQSystem'),
+        exchange_dq_system=_ref_of('DQSystem'),
+        exchanges=[_sample_exchange(), _sample_exchange()],
+        is_infrastructure_process=True,
+        last_change='2022-05-04T12:30:00Z',
+        last_internal_id=42,
+        library='library',
+        location=_ref_of('Location'),
+        name='name',
+        parameters=[_sample_parameter(), _sample_parameter()],
+        process_documentation=_sample_process_documentation(),
+        process_type=schema.ProcessType.LCI_RESULT,
+        social_aspects=[_sample_social_aspect(), _sample_social_aspect()],
+        social_dq_system=_ref_of('DQSystem'),
+        tags=['tags', 'tags 2'],
+        version='version',
+    )
+
+
+def _sample_product_system():
+    return schema.ProductSystem(
+        id='id',
+        category='category',
+        description='description',
+        last_change='2022-05-04T12:30:00Z',
+        library='library',
+        name='name',
+        parameter_sets=[
+            _sample_parameter_redef_set(),
+            _sample_parameter_redef_set(),
+        ],
+        process_links=[_sample_process_link(), _sample_process_link()],
+        processes=[_sample_ref(), _sample_ref()],
+        ref_exchange=_sample_exchange_ref(),
+        ref_process=_ref_of('Process'),
+        tags=['tags', 'tags 2'],
+        target_amount=42.5,
+        target_flow_property=_ref_of('FlowProperty'),
+        target_unit=_ref_of('Unit'),
+        version='version',
+    )
+
+
+def _sample_project():
+    return schema.Project(
+        id='id',
+        category='category',
+        description='description',
+        impact_method=_ref_of('ImpactMethod'),
+        is_with_costs=True,
+        is_with_regionalization=True,
+        last_change='2022-05-04T12:30:00Z',
+        library='library',
+        name='name',
+        nw_set=_sample_nw_set(),
+        tags=['tags', 'tags 2'],
+        variants=[_sample_project_variant(), _sample_project_variant()],
+        version='version',
+    )
+
+
+def _sample_result():
+    return schema.Result(
+        id='id',
+        category='category',
+        description='description',
+        flow_results=[_sample_flow_result(), _sample_flow_result()],
+        impact_method=_ref_of('ImpactMethod'),
+        impact_results=[_sample_impact_result(), _sample_impact_result()],
+        last_change='2022-05-04T12:30:00Z',
+        library='library',
+        name='name',
+        product_system=_ref_of('ProductSystem'),
+        tags=['tags', 'tags 2'],
+        version='version',
+    )
+
+
+def _sample_social_indicator():
+    return schema.SocialIndicator(
+        id='id',
+        activity_quantity=_ref_of('FlowProperty'),
+        activity_unit=_ref_of('Unit'),
+        activity_variable='activity_variable',
+        category='category',
+        description='description',
+        evaluation_scheme='evaluation_scheme',
+        last_change='2022-05-04T12:30:00Z',
+        library='library',
+        name='name',
+        tags=['tags', 'tags 2'],
+        unit_of_measurement='unit_of_measurement',
+        version='version',
+    )
+
+
+def _sample_source():
+    return schema.Source(
+        id='id',
+        category='category',
+        description='description',
+        external_file='external_file',
+        last_change='2022-05-04T12:30:00Z',
+        library='library',
+        name='name',
+        tags=['tags', 'tags 2'],
+        text_reference='text_reference',
+        url='url',
+        version='version',
+        year=42,
+    )
+
+
+def _sample_unit_group():
+    return schema.UnitGroup(
+        id='id',
+        category='category',
+        default_flow_property=_ref_of('FlowProperty'),
+        description='description',
+        last_change='2022-05-04T12:30:00Z',
+        library='library',
+        name='name',
+        tags=['tags', 'tags 2'],
+        units=[_sample_unit(), _sample_unit()],
+        version='version',
+    )
+
+
+class GeneratedRoundTripTest(unittest.TestCase):
+
+    def setUp(self):
+        warnings.simplefilter('ignore', DeprecationWarning)
+
+    def tearDown(self):
+        warnings.resetwarnings()
+
+    def test_allocation_factor(self):
+        allocation_factor = _sample_allocation_factor()
+        self._check_round_trip(allocation_factor)
+        for item in schema.AllocationType:
+            allocation_factor.allocation_type = item
+            self._check_round_trip(allocation_factor)
+        self._check_falsy_values(allocation_factor, {
+            'formula': '',
+            'value': 0.0,
+        })
+
+    def test_calculation_setup(self):
+        calculation_setup = _sample_calculation_setup()
+        self._check_round_trip(calculation_setup)
+        for item in schema.AllocationType:
+            calculation_setup.allocation = item
+            self._check_round_trip(calculation_setup)
+        for item in schema.CalculationType:
+            calculation_setup.calculation_type = item
+            self._check_round_trip(calculation_setup)
+        self._check_falsy_values(calculation_setup, {
+            'amount': 0.0,
+            'number_of_runs': 0,
+            'parameters': [],
+            'with_costs': False,
+            'with_regionalization': False,
+        })
+
+    def test_dq_indicator(self):
+        dq_indicator = _sample_dq_indicator()
+        self._check_round_trip(dq_indicator)
+        self._check_falsy_values(dq_indicator, {
+            'name': '',
+            'position': 0,
+            'scores': [],
+        })
+
+    def test_dq_score(self):
+        dq_score = _sample_dq_score()
+        self._check_round_trip(dq_score)
+        self._check_falsy_values(dq_score, {
+            'description': '',
+            'label': '',
+            'position': 0,
+            'uncertainty': 0.0,
+        })
+
+    def test_epd_module(self):
+        epd_module = _sample_epd_module()
+        self._check_round_trip(epd_module)
+        self._check_falsy_values(epd_module, {
+            'name': '',
+        })
+
+    def test_epd_product(self):
+        epd_product = _sample_epd_product()
+        self._check_round_trip(epd_product)
+        self._check_falsy_values(epd_product, {
+            'amount': 0.0,
+        })
+
+    def test_exchange(self):
+        exchange = _sample_exchange()
+        self._check_round_trip(exchange)
+        self._check_falsy_values(exchange, {
+            'amount': 0.0,
+            'amount_formula': '',
+            'base_uncertainty': 0.0,
+            'cost_formula': '',
+            'cost_value': 0.0,
+            'description': '',
+            'dq_entry': '',
+            'internal_id': 0,
+            'is_avoided_product': False,
+            'is_input': False,
+            'is_quantitative_reference': False,
+        })
+
+    def test_exchange_ref(self):
+        exchange_ref = _sample_exchange_ref()
+        self._check_round_trip(exchange_ref)
+        self._check_falsy_values(exchange_ref, {
+            'internal_id': 0,
+        })
+
+    def test_flow_map_entry(self):
+        flow_map_entry = _sample_flow_map_entry()
+        self._check_round_trip(flow_map_entry)
+        self._check_falsy_values(flow_map_entry, {
+            'conversion_factor': 0.0,
+        })
+
+    def test_flow_map_ref(self):
+        flow_map_ref = _sample_flow_map_ref()
+        self._check_round_trip(flow_map_ref)
+
+    def test_flow_property_factor(self):
+        flow_property_factor = _sample_flow_property_factor()
+        self._check_round_trip(flow_property_factor)
+        self._check_falsy_values(flow_property_factor, {
+            'conversion_factor': 0.0,
+            'is_ref_flow_property': False,
+        })
+
+    def test_flow_result(self):
+        flow_result = _sample_flow_result()
+        self._check_round_trip(flow_result)
+        self._check_falsy_values(flow_result, {
+            'amount': 0.0,
+            'description': '',
+            'is_input': False,
+            'is_ref_flow': False,
+        })
+
+    def test_impact_factor(self):
+        impact_factor = _sample_impact_factor()
+        self._check_round_trip(impact_factor)
+        self._check_falsy_values(impact_factor, {
+            'formula': '',
+            'value': 0.0,
+        })
+
+    def test_impact_result(self):
+        impact_result = _sample_impact_result()
+        self._check_round_trip(impact_result)
+        self._check_falsy_values(impact_result, {
+            'amount': 0.0,
+            'description': '',
+        })
+
+    def test_nw_factor(self):
+        nw_factor = _sample_nw_factor()
+        self._check_round_trip(nw_factor)
+        self._check_falsy_values(nw_factor, {
+            'normalisation_factor': 0.0,
+            'weighting_factor': 0.0,
+        })
+
+    def test_nw_set(self):
+        nw_set = _sample_nw_set()
+        self._check_round_trip(nw_set)
+        self._check_falsy_values(nw_set, {
+            'id': '',
+            'description': '',
+            'factors': [],
+            'name': '',
+            'weighted_score_unit': '',
+        })
+
+    def test_parameter_redef(self):
+        parameter_redef = _sample_parameter_redef()
+        self._check_round_trip(parameter_redef)
+        self._check_falsy_values(parameter_redef, {
+            'description': '',
+            'is_protected': False,
+            'name': '',
+            'value': 0.0,
+        })
+
+    def test_parameter_redef_set(self):
+        parameter_redef_set = _sample_parameter_redef_set()
+        self._check_round_trip(parameter_redef_set)
+        self._check_falsy_values(parameter_redef_set, {
+            'description': '',
+            'is_baseline': False,
+            'name': '',
+            'parameters': [],
+        })
+
+    def test_process_documentation(self):
+        process_documentation = _sample_process_documentation()
+        self._check_round_trip(process_documentation)
+        self._check_falsy_values(process_documentation, {
+            'completeness_description': '',
+            'creation_date': '',
+            'data_collection_description': '',
+            'data_selection_description': '',
+            'data_treatment_description': '',
+            'geography_description': '',
+            'intended_application': '',
+            'inventory_method_description': '',
+            'is_copyright_protected': False,
+            'modeling_constants_description': '',
+            'project_description': '',
+            'restrictions_description': '',
+            'review_details': '',
+            'sampling_description': '',
+            'sources': [],
+            'technology_description': '',
+            'time_description': '',
+            'valid_from': '',
+            'valid_until': '',
+        })
+
+    def test_process_link(self):
+        process_link = _sample_process_link()
+        self._check_round_trip(process_link)
+
+    def test_project_variant(self):
+        project_variant = _sample_project_variant()
+        self._check_round_trip(project_variant)
+        for item in schema.AllocationType:
+            project_variant.allocation_method = item
+            self._check_round_trip(project_variant)
+        self._check_falsy_values(project_variant, {
+            'amount': 0.0,
+            'description': '',
+            'is_disabled': False,
+            'name': '',
+            'parameter_redefs': [],
+        })
+
+    def test_ref(self):
+        ref = _sample_ref()
+        self._check_round_trip(ref)
+        for item in schema.FlowType:
+            ref.flow_type = item
+            self._check_round_trip(ref)
+        for item in schema.ProcessType:
+            ref.process_type = item
+            self._check_round_trip(ref)
+        self._check_falsy_values(ref, {
+            'id': '',
+            'category': '',
+            'description': '',
+            'library': '',
+            'location': '',
+            'name': '',
+            'ref_unit': '',
+        })
+
+    def test_social_aspect(self):
+        social_aspect = _sample_social_aspect()
+        self._check_round_trip(social_aspect)
+        for item in schema.RiskLevel:
+            social_aspect.risk_level = item
+            self._check_round_trip(social_aspect)
+        self._check_falsy_values(social_aspect, {
+            'activity_value': 0.0,
+            'comment': '',
+            'quality': '',
+            'raw_amount': '',
+        })
+
+    def test_uncertainty(self):
+        uncertainty = _sample_uncertainty()
+        self._check_round_trip(uncertainty)
+        for item in schema.UncertaintyType:
+            uncertainty.distribution_type = item
+            self._check_round_trip(uncertainty)
+        self._check_falsy_values(uncertainty, {
+            'geom_mean': 0.0,
+            'geom_mean_formula': '',
+            'geom_sd': 0.0,
+            'geom_sd_formula': '',
+            'maximum': 0.0,
+            'maximum_formula': '',
+            'mean': 0.0,
+            'mean_formula': '',
+            'minimum': 0.0,
+            'minimum_formula': '',
+            'mode': 0.0,
+            'mode_formula': '',
+            'sd': 0.0,
+            'sd_formula': '',
+        })
+
+    def test_unit(self):
+        unit = _sample_unit()
+        self._check_round_trip(unit)
+        self._check_ref(unit, 'Unit')
+        self._check_falsy_values(unit, {
+            'id': '',
+            'conversion_factor': 0.0,
+            'description': '',
+            'is_ref_unit': False,
+            'name': '',
+            'synonyms': [],
+        })
+
+    def test_actor(self):
+        actor = _sample_actor()
+        self._check_round_trip(actor)
+        self._check_json(actor)
+        self._check_ref(actor, 'Actor')
+        self._check_falsy_values(actor, {
+            'id': '',
+            'address': '',
+            'category': '',
+            'city': '',
+            'country': '',
+            'description': '',
+            'email': '',
+            'last_change': '',
+            'library': '',
+            'name': '',
+            'tags': [],
+            'telefax': '',
+            'telephone': '',
+            'version': '',
+            'website': '',
+            'zip_code': '',
+        })
+
+    def test_category(self):
+        category = _sample_category()
+        self._check_round_trip(category)
+        for item in schema.ModelType:
+            category.model_type = item
+            self._check_round_trip(category)
+        self._check_json(category)
+        self._check_ref(category, 'Category')
+        self._check_falsy_values(category, {
+            'id': '',
+            'category': '',
+            'description': '',
+            'last_change': '',
+            'library': '',
+            'name': '',
+            'tags': [],
+            'version': '',
+        })
+
+    def test_currency(self):
+        currency = _sample_currency()
+        self._check_round_trip(currency)
+        self._check_json(currency)
+        self._check_ref(currency, 'Currency')
+        self._check_falsy_values(currency, {
+            'id': '',
+            'category': '',
+            'code': '',
+            'conversion_factor': 0.0,
+            'description': '',
+            'last_change': '',
+            'library': '',
+            'name': '',
+            'tags': [],
+            'version': '',
+        })
+
+    def test_dq_system(self):
+        dq_system = _sample_dq_system()
+        self._check_round_trip(dq_system)
+        self._check_json(dq_system)
+        self._check_ref(dq_system, 'DQSystem')
+        self._check_falsy_values(dq_system, {
+            'id': '',
+            'category': '',
+            'description': '',
+            'has_uncertainties': False,
+            'indicators': [],
+            'last_change': '',
+            'library': '',
+            'name': '',
+            'tags': [],
+            'version': '',
+        })
+
+    def test_epd(self):
+        epd = _sample_epd()
+        self._check_round_trip(epd)
+        self._check_json(epd)
+        self._check_ref(epd, 'Epd')
+        self._check_falsy_values(epd, {
+            'id': '',
+            'category': '',
+            'description': '',
+            'last_change': '',
+            'library': '',
+            'modules': [],
+            'name': '',
+            'tags': [],
+            'urn': '',
+            'version': '',
+        })
+
+    def test_flow(self):
+        flow = _sample_flow()
+        self._check_round_trip(flow)
+        for item in schema.FlowType:
+            flow.flow_type = item
+            self._check_round_trip(flow)
+        self._check_json(flow)
+        self._check_ref(flow, 'Flow')
+        self._check_falsy_values(flow, {
+            'id': '',
+            'cas': '',
+            'category': '',
+            'description': '',
+            'flow_properties': [],
+            'formula': '',
+            'is_infrastructure_flow': False,
+            'last_change': '',
+            'library': '',
+            'name': '',
+            'synonyms': '',
+            'tags': [],
+            'version': '',
+        })
+
+    def test_flow_map(self):
+        flow_map = _sample_flow_map()
+        self._check_round_trip(flow_map)
+        self._check_json(flow_map)
+        self._check_ref(flow_map, 'FlowMap')
+        self._check_falsy_values(flow_map, {
+            'id': '',
+            'category': '',
+            'description': '',
+            'last_change': '',
+            'library': '',
+            'mappings': [],
+            'name': '',
+            'tags': [],
+            'version': '',
+        })
+
+    def test_flow_property(self):
+        flow_property = _sample_flow_property()
+        self._check_round_trip(flow_property)
+        for item in schema.FlowPropertyType:
+            flow_property.flow_property_type = item
+            self._check_round_trip(flow_property)
+        self._check_json(flow_property)
+        self._check_ref(flow_property, 'FlowProperty')
+        self._check_falsy_values(flow_property, {
+            'id': '',
+            'category': '',
+            'description': '',
+            'last_change': '',
+            'library': '',
+            'name': '',
+            'tags': [],
+            'version': '',
+        })
+
+    def test_impact_category(self):
+        impact_category = _sample_impact_category()
+        self._check_round_trip(impact_category)
+        self._check_json(impact_category)
+        self._check_ref(impact_category, 'ImpactCategory')
+        self._check_falsy_values(impact_category, {
+            'id': '',
+            'category': '',
+            'code': '',
+            'description': '',
+            'impact_factors': [],
+            'last_change': '',
+            'library': '',
+            'name': '',
+            'parameters': [],
+            'ref_unit': '',
+            'tags': [],
+            'version': '',
+        })
+
+    def test_impact_method(self):
+        impact_method = _sample_impact_method()
+        self._check_round_trip(impact_method)
+        self._check_json(impact_method)
+        self._check_ref(impact_method, 'ImpactMethod')
+        self._check_falsy_values(impact_method, {
+            'id': '',
+            'category': '',
+            'code': '',
+            'description': '',
+            'impact_categories': [],
+            'last_change': '',
+            'library': '',
+            'name': '',
+            'nw_sets': [],
+            'tags': [],
+            'version': '',
+        })
+
+    def test_location(self):
+        location = _sample_location()
+        self._check_round_trip(location)
+        self._check_json(location)
+        self._check_ref(location, 'Location')
+        self._check_falsy_values(location, {
+            'id': '',
+            'category': '',
+            'code': '',
+            'description': '',
+            'last_change': '',
+            'latitude': 0.0,
+            'library': '',
+            'longitude': 0.0,
+            'name': '',
+            'tags': [],
+            'version': '',
+        })
+
+    def test_parameter(self):
+        parameter = _sample_parameter()
+        self._check_round_trip(parameter)
+        for item in schema.ParameterScope:
+            parameter.parameter_scope = item
+            self._check_round_trip(parameter)
+        self._check_json(parameter)
+        self._check_ref(parameter, 'Parameter')
+        self._check_falsy_values(parameter, {
+            'id': '',
+            'category': '',
+            'description': '',
+            'formula': '',
+            'is_input_parameter': False,
+            'last_change': '',
+            'library': '',
+            'name': '',
+            'tags': [],
+            'value': 0.0,
+            'version': '',
+        })
+
+    def test_process(self):
+        process = _sample_process()
+        self._check_round_trip(process)
+        for item in schema.AllocationType:
+            process.default_allocation_method = item
+            self._check_round_trip(process)
+        for item in schema.ProcessType:
+            process.process_type = item
+            self._check_round_trip(process)
+        self._check_json(process)
+        self._check_ref(process, 'Process')
+        self._check_falsy_values(process, {
+            'id': '',
+            'allocation_factors': [],
+            'category': '',
+            'description': '',
+            'dq_entry': '',
+            'exchanges': [],
+            'is_infrastructure_process': False,
+            'last_change': '',
+            'last_internal_id': 0,
+            'library': '',
+            'name': '',
+            'parameters': [],
+            'social_aspects': [],
+            'tags': [],
+            'version': '',
+        })
+
+    def test_product_system(self):
+        product_system = _sample_product_system()
+        self._check_round_trip(product_system)
+        self._check_json(product_system)
+        self._check_ref(product_system, 'ProductSystem')
+        self._check_falsy_values(product_system, {
+            'id': '',
+            'category': '',
+            'description': '',
+            'last_change': '',
+            'library': '',
+            'name': '',
+            'parameter_sets': [],
+            'process_links': [],
+            'processes': [],
+            'tags': [],
+            'target_amount': 0.0,
+            'version': '',
+        })
+
+    def test_project(self):
+        project = _sample_project()
+        self._check_round_trip(project)
+        self._check_json(project)
+        self._check_ref(project, 'Project')
+        self._check_falsy_values(project, {
+            'id': '',
+            'category': '',
+            'description': '',
+            'is_with_costs': False,
+            'is_with_regionalization': False,
+            'last_change': '',
+            'library': '',
+            'name': '',
+            'tags': [],
+            'variants': [],
+            'version': '',
+        })
+
+    def test_result(self):
+        result = _sample_result()
+        self._check_round_trip(result)
+        self._check_json(result)
+        self._check_ref(result, 'Result')
+        self._check_falsy_values(result, {
+            'id': '',
+            'category': '',
+            'description': '',
+            'flow_results': [],
+            'impact_results': [],
+            'last_change': '',
+            'library': '',
+            'name': '',
+            'tags': [],
+            'version': '',
+        })
+
+    def test_social_indicator(self):
+        social_indicator = _sample_social_indicator()
+        self._check_round_trip(social_indicator)
+        self._check_json(social_indicator)
+        self._check_ref(social_indicator, 'SocialIndicator')
+        self._check_falsy_values(social_indicator, {
+            'id': '',
+            'activity_variable': '',
+            'category': '',
+            'description': '',
+            'evaluation_scheme': '',
+            'last_change': '',
+            'library': '',
+            'name': '',
+            'tags': [],
+            'unit_of_measurement': '',
+            'version': '',
+        })
+
+    def test_source(self):
+        source = _sample_source()
+        self._check_round_trip(source)
+        self._check_json(source)
+        self._check_ref(source, 'Source')
+        self._check_falsy_values(source, {
+            'id': '',
+            'category': '',
+            'description': '',
+            'external_file': '',
+            'last_change': '',
+            'library': '',
+            'name': '',
+            'tags': [],
+            'text_reference': '',
+            'url': '',
+            'version': '',
+            'year': 0,
+        })
+
+    def test_unit_group(self):
+        unit_group = _sample_unit_group()
+        self._check_round_trip(unit_group)
+        self._check_json(unit_group)
+        self._check_ref(unit_group, 'UnitGroup')
+        self._check_falsy_values(unit_group, {
+            'id': '',
+            'category': '',
+            'description': '',
+            'last_change': '',
+            'library': '',
+            'name': '',
+            'tags': [],
+            'units': [],
+            'version': '',
+        })
+
+    def _check_round_trip(self, instance):
+        data = json.loads(json.dumps(instance.to_dict()))
+        clone = type(instance).from_dict(data)
+        self.assertEqual(instance, clone)
+        self.assertEqual(instance.to_dict(), clone.to_dict())
+
+    def _check_json(self, instance):
+        clone = type(instance).from_json(instance.to_json())
+        self.assertEqual(instance, clone)
+
+    def _check_falsy_values(self, instance, values):
+        for name, value in values.items():
+            setattr(instance, name, value)
+        self._check_round_trip(instance)
+        data = json.loads(json.dumps(instance.to_dict()))
+        clone = type(instance).from_dict(data)
+        for name, value in values.items():
+            actual = getattr(clone, name)
+            self.assertEqual((type(value), value), (type(actual), actual))
+
+    def _check_ref(self, instance, model_type):
+        ref = instance.to_ref()
+        self.assertEqual(instance.id, ref.id)
+        self.assertEqual(instance.name, ref.name)
+        self.assertEqual(model_type, ref.model_type)
+        self.assertEqual(
+            getattr(instance, 'category', None), ref.category)
+        self._check_round_trip(ref)
+
+
+if __name__ == '__main__':
+    unittest.main()
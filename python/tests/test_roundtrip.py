@@ -0,0 +1,107 @@
+import dataclasses
+import datetime
+import enum
+import typing
+import unittest
+import warnings
+
+import olca_schema.schema as schema
+
+
+def _classes():
+    return [c for c in vars(schema).values()
+            if dataclasses.is_dataclass(c) and isinstance(c, type)
+            and c.__module__ == schema.__name__]
+
+
+def _value_of(t, depth: int):
+    """Creates a non-empty value of the given type hint."""
+    origin = typing.get_origin(t)
+    args = typing.get_args(t)
+    if origin is typing.Union:
+        alternatives = [a for a in args if a is not type(None)]
+        return _value_of(alternatives[0], depth)
+    if origin is list:
+        return [_value_of(args[0], depth)]
+    if origin is dict:
+        return {'key': _value_of(args[1], depth)}
+    if origin is schema.Ref:
+        ref = _instance_of(schema.Ref, depth + 1)
+        ref.model_type = args[0].__name__
+        return ref
+    if t is str:
+        return 'text'
+    if t is bool:
+        return True
+    if t is int:
+        return 42
+    if t is float:
+        return 42.5
+    if t is datetime.datetime:
+        return datetime.datetime(2022, 5, 4, 12, 30, tzinfo=datetime.timezone.utc)
+    if t is datetime.date:
+        return datetime.date(2022, 5, 4)
+    if isinstance(t, type) and issubclass(t, enum.Enum):
+        return list(t)[-1]
+    if dataclasses.is_dataclass(t):
+        return _instance_of(t, depth + 1)
+    return 'value'
+
+
+def _is_required(f: dataclasses.Field) -> bool:
+    return (f.default is dataclasses.MISSING
+            and f.default_factory is dataclasses.MISSING)
+
+
+def _instance_of(cls, depth: int = 0):
+    """Creates an instance of the given class with all fields set; nested
+    objects are only filled up to a fixed depth to avoid cycles, except for
+    the required fields."""
+    hints = typing.get_type_hints(cls, vars(schema))
+    values = {}
+    for f in dataclasses.fields(cls):
+        if depth <= 2 or _is_required(f):
+            values[f.name] = _value_of(hints[f.name], depth)
+    return cls(**values)
+
+
+class RoundTripTest(unittest.TestCase):
+
+    def setUp(self):
+        warnings.simplefilter('ignore', DeprecationWarning)
+
+    def tearDown(self):
+        warnings.resetwarnings()
+
+    def test_round_trip(self):
+        for cls in _classes():
+            with self.subTest(cls=cls.__name__):
+                instance = _instance_of(cls)
+                clone = cls.from_dict(instance.to_dict())
+                self.assertEqual(instance, clone)
+
+    def test_enums(self):
+        flow = schema.Flow(name='Steel', flow_type=schema.FlowType.PRODUCT_FLOW)
+        clone = schema.Flow.from_dict(flow.to_dict())
+        self.assertIs(schema.FlowType.PRODUCT_FLOW, clone.flow_type)
+        self.assertEqual('PRODUCT_FLOW', clone.to_dict()['flowType'])
+
+    def test_refs(self):
+        actor = schema.Actor(name='ACME')
+        epd = schema.Epd(name='EPD', manufacturer=actor.to_ref())
+        clone = schema.Epd.from_dict(epd.to_dict())
+        self.assertIsInstance(clone.manufacturer, schema.Ref)
+        self.assertEqual(actor.id, clone.manufacturer.id)
+
+    def test_ref_type(self):
+        flow = schema.Flow(name='Steel')
+        d = schema.Epd(name='EPD', manufacturer=flow.to_ref()).to_dict()
+        with self.assertRaises(ValueError):
+            schema.Epd.from_dict(d)
+        del d['manufacturer']['@type']
+        clone = schema.Epd.from_dict(d)
+        self.assertEqual(flow.id, clone.manufacturer.id)
+
+
+if __name__ == '__main__':
+    unittest.main()
@@ -0,0 +1,37 @@
+import unittest
+
+import olca_schema.schema as schema
+
+
+class ToDictTest(unittest.TestCase):
+
+    def test_falsy_values(self):
+        exchange = schema.Exchange(
+            amount=0, is_input=False, description='', internal_id=0)
+        d = exchange.to_dict()
+        self.assertEqual(0, d['amount'])
+        self.assertIs(False, d['isInput'])
+        self.assertEqual('', d['description'])
+        self.assertEqual(0, d['internalId'])
+
+        clone = schema.Exchange.from_dict(d)
+        self.assertEqual(0, clone.amount)
+        self.assertIs(False, clone.is_input)
+        self.assertEqual('', clone.description)
+        self.assertEqual(0, clone.internal_id)
+
+    def test_none_values(self):
+        d = schema.Exchange().to_dict()
+        self.assertNotIn('amount', d)
+        self.assertNotIn('isInput', d)
+        self.assertNotIn('description', d)
+
+    def test_empty_lists(self):
+        process = schema.Process(name='Steel', exchanges=[])
+        d = process.to_dict()
+        self.assertEqual([], d['exchanges'])
+        self.assertEqual([], schema.Process.from_dict(d).exchanges)
+
+
+if __name__ == '__main__':
+    unittest.main()
@@ -0,0 +1,75 @@
+import unittest
+
+import olca_schema.schema as schema
+
+
+class ValidateTest(unittest.TestCase):
+
+    def test_valid(self):
+        process = schema.Process(name='Steel', exchanges=[
+            schema.Exchange(amount=1.0, internal_id=1)])
+        self.assertEqual([], process.validate())
+
+    def test_missing_required(self):
+        process = schema.Process.from_dict({'@type': 'Process'})
+        self.assertIsNone(process.name)
+        self.assertIn('name: value is required', process.validate())
+
+    def test_wrong_enum_value(self):
+        flow = schema.Flow(name='Steel')
+        flow.flow_type = 'PRODUCT_FLOW'
+        errors = flow.validate()
+        self.assertEqual(1, len(errors))
+        self.assertTrue(errors[0].startswith('flow_type: expected FlowType'))
+
+    def test_wrong_list_element(self):
+        process = schema.Process(name='Steel', exchanges=[
+            schema.Exchange(), schema.Flow(name='Steel')])
+        errors = process.validate()
+        self.assertEqual(1, len(errors))
+        self.assertTrue(errors[0].startswith('exchanges[1]: expected Exchange'))
+
+    def test_wrong_list_type(self):
+        process = schema.Process(name='Steel')
+        process.exchanges = 5
+        errors = process.validate()
+        self.assertEqual(1, len(errors))
+        self.assertTrue(errors[0].startswith('exchanges: expected List[Exchange]'))
+
+        flow = schema.Flow(name='Steel', tags='abc')
+        errors = flow.validate()
+        self.assertEqual(1, len(errors))
+        self.assertTrue(errors[0].startswith('tags: expected List[string]'))
+
+    def test_bool_is_not_a_number(self):
+        exchange = schema.Exchange(amount=True, internal_id=1)
+        errors = exchange.validate()
+        self.assertEqual(1, len(errors))
+        self.assertTrue(errors[0].startswith('amount: expected double'))
+
+        exchange = schema.Exchange(amount=1, internal_id=True)
+        errors = exchange.validate()
+        self.assertEqual(1, len(errors))
+        self.assertTrue(errors[0].startswith('internal_id: expected int'))
+
+        exchange = schema.Exchange(amount=1, internal_id=1, is_input=True)
+        self.assertEqual([], exchange.validate())
+
+    def test_nested_paths(self):
+        process = schema.Process(name='Steel', allocation_factors=[
+            schema.AllocationFactor(
+                allocation_type=schema.AllocationType.PHYSICAL_ALLOCATION,
+                product=schema.Ref(id='f', name='Steel'),
+                value=None)])
+        self.assertEqual(['allocation_factors[0].value: value is required'],
+                         process.validate())
+
+        process.allocation_factors[0].value = 1
+        process.allocation_factors[0].product.name = None
+        self.assertEqual(
+            ['allocation_factors[0].product.name: value is required'],
+            process.validate())
+
+
+if __name__ == '__main__':
+    unittest.main()
@@ -1,6 +1,8 @@
+import json
 import os
 import tempfile
 import unittest
+import zipfile
 
 import olca_schema.zipio as zipio
 from olca_schema import *
@@ -44,6 +46,37 @@ class ZipioTest(unittest.TestCase):
                 self.assertEqual(uid(c), instance.name)
         os.remove(zip_file)
 
+    def test_read_all(self):
+        zip_file = tempfile.mktemp('.zip')
+        actors = [Actor(name=f'Actor {i}') for i in range(3)]
+        with zipio.ZipWriter(zip_file) as writer:
+            for actor in actors:
+                writer.write(actor)
+            writer.write(ImpactCategory(name='GWP'))
+        with zipio.ZipReader(zip_file) as reader:
+            self.assertEqual(sorted(a.id for a in actors),
+                             sorted(reader.ids(Actor)))
+            names = sorted(a.name for a in reader.iter_actors())
+            self.assertEqual(['Actor 0', 'Actor 1', 'Actor 2'], names)
+            categories = reader.read_all_impact_categories()
+            self.assertEqual(1, len(categories))
+            self.assertEqual('GWP', categories[0].name)
+            self.assertEqual([], reader.read_all(Source))
+        with zipfile.ZipFile(zip_file) as z:
+            path = f'lcia_categories/{categories[0].id}.json'
+            self.assertIn(path, z.namelist())
+        os.remove(zip_file)
+
+    def test_schema_version(self):
+        zip_file = tempfile.mktemp('.zip')
+        with zipio.ZipWriter(zip_file):
+            pass
+        with zipfile.ZipFile(zip_file) as z:
+            meta = json.loads(z.read('olca-schema.json'))
+        self.assertEqual(2, meta['version'])
+        self.assertEqual(schema.SCHEMA_VERSION, meta['schemaVersion'])
+        os.remove(zip_file)
+
 
 if __name__ == '__main__':
     unittest.main()